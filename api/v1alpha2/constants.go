@@ -8,4 +8,9 @@ const (
 	OperationReconcile         = "reconcile"
 	ProviderConfigTypeLabel    = LandscaperDomain + "/providertype"
 	DefaultProviderConfigValue = "default"
+
+	// WatchLabel marks the secrets on the onboarding cluster which the provider watches for changes. A change of a
+	// referenced secret without this label is only applied with the next reconciliation of the Landscaper resource.
+	WatchLabel      = LandscaperDomain + "/watch"
+	WatchLabelValue = "true"
 )
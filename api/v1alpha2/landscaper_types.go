@@ -17,6 +17,8 @@ limitations under the License.
 package v1alpha2
 
 import (
+	"slices"
+
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -29,9 +31,10 @@ const (
 	PhaseTerminating LandscaperPhase = "Terminating"
 	PhaseReady       LandscaperPhase = "Ready"
 
-	ConditionTypeInstalled   = "Installed"
-	ConditionTypeUninstalled = "Uninstalled"
-	ConditionTypeReady       = "Ready"
+	ConditionTypeInstalled        = "Installed"
+	ConditionTypeUninstalled      = "Uninstalled"
+	ConditionTypeReady            = "Ready"
+	ConditionTypeWebhookReachable = "WebhookReachable"
 
 	ConditionReasonInstallationPending    = "InstallationPending"
 	ConditionReasonReadinessCheckPending  = "ReadinessCheckPending"
@@ -40,14 +43,62 @@ const (
 
 	ConditionReasonLandscaperInstalled = "LandscaperInstalled"
 	ConditionReasonLandscaperReady     = "LandscaperReady"
+	ConditionReasonQuotaExhausted      = "QuotaExhausted"
 
 	ConditionReasonInstallFailed       = "InstallFailed"
 	ConditionReasonClusterAccessError  = "ClusterAccessError"
 	ConditionReasonProviderConfigError = "ProviderConfigError"
 	ConditionReasonConfigurationError  = "ConfigurationError"
 
-	ConditionReasonDNSConfigFailed = "DNSConfigFailed"
-	ConditionReasonWaitForDNSReady = "WaitForDNSReady"
+	ConditionReasonDNSConfigFailed        = "DNSConfigFailed"
+	ConditionReasonWaitForDNSReady        = "WaitForDNSReady"
+	ConditionReasonNoMatchingListener     = "NoMatchingListener"
+	ConditionReasonGatewayNotProgrammed   = "GatewayNotProgrammed"
+	ConditionReasonRouteNotAccepted       = "RouteNotAccepted"
+	ConditionReasonBackendNotFound        = "BackendNotFound"
+	ConditionReasonRefNotPermitted        = "RefNotPermitted"
+	ConditionReasonBackendRefsNotResolved = "BackendRefsNotResolved"
+
+	ConditionReasonWebhookReachable   = "WebhookReachable"
+	ConditionReasonWebhookUnreachable = "WebhookUnreachable"
+)
+
+type DNSMigrationPhase string
+
+const (
+	// DNSMigrationPhaseRouting means that the TLSRoute routes the previous and the new hostname,
+	// while the webhooks server is still registered with the previous hostname.
+	DNSMigrationPhaseRouting DNSMigrationPhase = "Routing"
+	// DNSMigrationPhaseSwitched means that the webhooks server is registered with the new hostname.
+	// The previous hostname remains routed until the grace period has expired.
+	DNSMigrationPhaseSwitched DNSMigrationPhase = "Switched"
+)
+
+// DNSScope distinguishes the hostnames of the webhooks server by the base domain of the gateway from which they are derived.
+// +kubebuilder:validation:Enum=External;Internal
+type DNSScope string
+
+const (
+	// DNSScopeExternal is the scope of the hostname derived from the external base domain of the gateway.
+	DNSScopeExternal DNSScope = "External"
+	// DNSScopeInternal is the scope of the hostname derived from the internal base domain of the gateway.
+	// This hostname is only resolvable from clusters in the internal network of the platform.
+	DNSScopeInternal DNSScope = "Internal"
+)
+
+// Webhook identifies a webhook of the Landscaper webhooks server.
+// +kubebuilder:validation:Enum=all;installation;execution;deployitem
+type Webhook string
+
+const (
+	// WebhookAll stands for all webhooks of the webhooks server.
+	WebhookAll Webhook = "all"
+	// WebhookInstallation is the webhook validating installations.
+	WebhookInstallation Webhook = "installation"
+	// WebhookExecution is the webhook validating executions.
+	WebhookExecution Webhook = "execution"
+	// WebhookDeployItem is the webhook validating deploy items.
+	WebhookDeployItem Webhook = "deployitem"
 )
 
 // LandscaperComponent represents a component of the Landscaper instance.
@@ -71,6 +122,299 @@ type LandscaperSpec struct {
 	// +kubebuilder:validation:Required
 	// +kubebuilder:validation:MinLength=1
 	Version string `json:"version,omitempty"`
+
+	// WebhooksDNSScope selects the hostname with which the webhooks server is registered in the MCP cluster.
+	// Use Internal if the MCP cluster can only resolve hostnames of the internal base domain of the gateway.
+	// Defaults to External.
+	// +optional
+	WebhooksDNSScope DNSScope `json:"webhooksDNSScope,omitempty"`
+
+	// DisableWebhooks lists the webhooks of the webhooks server which are disabled.
+	// If all webhooks are disabled, the webhooks server is not installed, and the Landscaper instance needs no gateway.
+	// +optional
+	// +listType=set
+	DisableWebhooks []Webhook `json:"disableWebhooks,omitempty"`
+
+	// Configuration configures the Landscaper controllers.
+	// The ProviderConfig can limit the values in its configurationBounds.
+	// +optional
+	Configuration *ControllerConfiguration `json:"configuration,omitempty"`
+
+	// Registry configures the access of the Landscaper to the OCI registries from which it fetches
+	// component descriptors and blueprints. The registries are added to those configured in the ProviderConfig.
+	// +optional
+	Registry *RegistrySpec `json:"registry,omitempty"`
+
+	// HelmDeployer configures the helm deployer of the Landscaper instance.
+	// +optional
+	HelmDeployer *HelmDeployerSpec `json:"helmDeployer,omitempty"`
+
+	// ManifestDeployer configures the manifest deployer of the Landscaper instance.
+	// +optional
+	ManifestDeployer *DeployerSpec `json:"manifestDeployer,omitempty"`
+
+	// Deployers selects the deployers which are installed in addition to the helm and manifest deployer,
+	// for example "container" or "mock". Each deployer must be offered in the ProviderConfig.
+	// +optional
+	// +listType=set
+	Deployers []string `json:"deployers,omitempty"`
+}
+
+// AreAllWebhooksDisabled returns true if either "all" or each single webhook is disabled.
+func (s *LandscaperSpec) AreAllWebhooksDisabled() bool {
+	if slices.Contains(s.DisableWebhooks, WebhookAll) {
+		return true
+	}
+	for _, w := range []Webhook{WebhookInstallation, WebhookExecution, WebhookDeployItem} {
+		if !slices.Contains(s.DisableWebhooks, w) {
+			return false
+		}
+	}
+	return true
+}
+
+// Verbosity is the log level of the Landscaper controllers.
+// +kubebuilder:validation:Enum=ERROR;INFO;DEBUG
+type Verbosity string
+
+const (
+	VerbosityError Verbosity = "ERROR"
+	VerbosityInfo  Verbosity = "INFO"
+	VerbosityDebug Verbosity = "DEBUG"
+)
+
+// ControllerConfiguration is the part of the configuration of the Landscaper controllers which Landscaper resources can set.
+type ControllerConfiguration struct {
+	// DeployItemTimeouts configures the timeouts of deploy items.
+	// +optional
+	DeployItemTimeouts *DeployItemTimeouts `json:"deployItemTimeouts,omitempty"`
+
+	// ExcludedNamespaces are namespaces of the MCP cluster in which the Landscaper creates no default context.
+	// The namespace kube-system is always excluded.
+	// +optional
+	// +listType=set
+	ExcludedNamespaces []string `json:"excludedNamespaces,omitempty"`
+
+	// Workers configures the number of workers of the Landscaper controllers.
+	// +optional
+	Workers *ControllerWorkers `json:"workers,omitempty"`
+
+	// Verbosity is the log level of the Landscaper controllers. Defaults to INFO.
+	// +optional
+	Verbosity Verbosity `json:"verbosity,omitempty"`
+}
+
+// DeployItemTimeouts configures the timeouts of deploy items.
+type DeployItemTimeouts struct {
+	// Pickup is the time within which a deployer must pick up a deploy item. Defaults to 60 minutes.
+	// +optional
+	Pickup *metav1.Duration `json:"pickup,omitempty"`
+
+	// Abort is the time within which a deployer must abort a deploy item after it has been asked to.
+	// +optional
+	Abort *metav1.Duration `json:"abort,omitempty"`
+}
+
+// ControllerWorkers configures the number of workers of the Landscaper controllers.
+type ControllerWorkers struct {
+	// Installations is the number of workers of the installation controller. Defaults to 30.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	Installations *int32 `json:"installations,omitempty"`
+
+	// Executions is the number of workers of the execution controller. Defaults to 30.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	Executions *int32 `json:"executions,omitempty"`
+
+	// DeployItems is the number of workers of the deploy item controller. Defaults to 5.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	DeployItems *int32 `json:"deployItems,omitempty"`
+
+	// Contexts is the number of workers of the context controller. Defaults to 5.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	Contexts *int32 `json:"contexts,omitempty"`
+}
+
+// DeployerSpec configures a deployer which is installed by default.
+type DeployerSpec struct {
+	// Disabled opts out of the deployer. A disabled deployer is not installed, or uninstalled if it has been installed before.
+	// +optional
+	Disabled bool `json:"disabled,omitempty"`
+
+	// Configuration configures the deployer.
+	// +optional
+	Configuration *DeployerConfiguration `json:"configuration,omitempty"`
+}
+
+// IsDisabled returns true if the deployer has been opted out.
+func (d *DeployerSpec) IsDisabled() bool {
+	return d != nil && d.Disabled
+}
+
+// GetConfiguration returns the configuration of the deployer, or nil if the defaults apply.
+func (d *DeployerSpec) GetConfiguration() *DeployerConfiguration {
+	if d == nil {
+		return nil
+	}
+	return d.Configuration
+}
+
+// DeployerConfiguration is the part of the configuration of the helm and manifest deployer which Landscaper resources can set.
+type DeployerConfiguration struct {
+	// TargetSelectors restrict the deploy items which the deployer processes to those whose target matches one of the selectors.
+	// +optional
+	TargetSelectors []TargetSelector `json:"targetSelectors,omitempty"`
+
+	// DefaultExportTimeout is the time within which the deployer must collect the exports of a deploy item,
+	// unless the deploy item specifies a timeout itself.
+	// +optional
+	DefaultExportTimeout *metav1.Duration `json:"defaultExportTimeout,omitempty"`
+
+	// Workers is the number of workers of the deployer. Defaults to 30.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	Workers *int32 `json:"workers,omitempty"`
+
+	// MaxReplicas is the maximum number of replicas to which the deployer is scaled. Defaults to 1.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	MaxReplicas *int32 `json:"maxReplicas,omitempty"`
+
+	// Verbosity is the log level of the deployer. Defaults to INFO.
+	// +optional
+	Verbosity Verbosity `json:"verbosity,omitempty"`
+}
+
+// TargetSelector selects targets by reference, annotations or labels. All given criteria must match.
+type TargetSelector struct {
+	// Targets lists the targets which match the selector.
+	// +optional
+	Targets []TargetReference `json:"targets,omitempty"`
+
+	// Annotations are requirements for the annotations of a target.
+	// +optional
+	Annotations []SelectorRequirement `json:"annotations,omitempty"`
+
+	// Labels are requirements for the labels of a target.
+	// +optional
+	Labels []SelectorRequirement `json:"labels,omitempty"`
+}
+
+// TargetReference references a target on the MCP cluster.
+type TargetReference struct {
+	// Name is the name of the target.
+	Name string `json:"name"`
+
+	// Namespace is the namespace of the target.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// SelectorRequirement is a requirement for the annotations or labels of a target.
+type SelectorRequirement struct {
+	// Key is the annotation or label key.
+	Key string `json:"key"`
+
+	// Operator relates the key to the values.
+	// The operators "in" and "notin" require at least one value, "exists" and "!" (does not exist) none,
+	// and "=", "==" and "!=" exactly one.
+	// +kubebuilder:validation:Enum=in;notin;exists;!;=;==;!=
+	Operator string `json:"operator"`
+
+	// Values are the values of the requirement.
+	// +optional
+	Values []string `json:"values,omitempty"`
+}
+
+// HelmDeployerSpec configures the helm deployer of the Landscaper instance.
+type HelmDeployerSpec struct {
+	DeployerSpec `json:",inline"`
+
+	// Registries configures the access of the helm deployer to the OCI registries from which it pulls helm charts.
+	// +optional
+	// +listType=map
+	// +listMapKey=host
+	Registries []OCIRegistry `json:"registries,omitempty"`
+}
+
+// IsDisabled returns true if the helm deployer has been opted out.
+func (h *HelmDeployerSpec) IsDisabled() bool {
+	return h != nil && h.Disabled
+}
+
+// GetConfiguration returns the configuration of the helm deployer, or nil if the defaults apply.
+func (h *HelmDeployerSpec) GetConfiguration() *DeployerConfiguration {
+	if h == nil {
+		return nil
+	}
+	return h.Configuration
+}
+
+// GetRegistries returns the configured OCI registries of the helm deployer.
+func (h *HelmDeployerSpec) GetRegistries() []OCIRegistry {
+	if h == nil {
+		return nil
+	}
+	return h.Registries
+}
+
+// RegistrySpec configures the access of the Landscaper to OCI registries.
+type RegistrySpec struct {
+	// Registries configures the access to single OCI registries.
+	// +optional
+	// +listType=map
+	// +listMapKey=host
+	Registries []OCIRegistry `json:"registries,omitempty"`
+
+	// Cache configures the cache of the Landscaper for OCI artifacts.
+	// It replaces the cache configuration of the ProviderConfig.
+	// +optional
+	Cache *OCICacheSpec `json:"cache,omitempty"`
+}
+
+// GetRegistries returns the configured OCI registries.
+func (r *RegistrySpec) GetRegistries() []OCIRegistry {
+	if r == nil {
+		return nil
+	}
+	return r.Registries
+}
+
+// GetCache returns the cache configuration, or nil if none is configured.
+func (r *RegistrySpec) GetCache() *OCICacheSpec {
+	if r == nil {
+		return nil
+	}
+	return r.Cache
+}
+
+// OCIRegistry configures the access to an OCI registry.
+type OCIRegistry struct {
+	// Host is the host of the registry, optionally with port, for example "registry.example.com:5000".
+	// +kubebuilder:validation:MinLength=1
+	Host string `json:"host"`
+
+	// SecretRef references a secret of type kubernetes.io/dockerconfigjson with the credentials for the registry.
+	// The Landscaper or deployer using the registry is restarted when the content of the secret changes.
+	// In a Landscaper resource, a secret with source Platform must be offered in the registrySecrets of the ProviderConfig,
+	// which determine its location, and a secret with source Onboarding is read from the namespace of the Landscaper resource.
+	// +optional
+	SecretRef *SourceReference `json:"secretRef,omitempty"`
+
+	// AllowPlainHTTP allows to access the registry via http.
+	// The Landscaper and the helm deployer do not distinguish registries here,
+	// so the flag applies to all registries if it is set for one of them.
+	// +optional
+	AllowPlainHTTP bool `json:"allowPlainHttp,omitempty"`
+
+	// InsecureSkipVerify disables the verification of the certificate of the registry.
+	// The Landscaper and the helm deployer do not distinguish registries here,
+	// so the flag applies to all registries if it is set for one of them.
+	// +optional
+	InsecureSkipVerify bool `json:"insecureSkipVerify,omitempty"`
 }
 
 // LandscaperStatus defines the observed state of Landscaper.
@@ -87,6 +431,86 @@ type LandscaperStatus struct {
 
 	// The current phase of the Landscaper instance deployment.
 	Phase LandscaperPhase `json:"phase,omitempty"`
+
+	// DNS contains the hostname under which the webhooks server of the Landscaper instance is published.
+	// +optional
+	DNS *DNSStatus `json:"dns,omitempty"`
+	// Deployers are the additional deployers which are installed for the Landscaper instance.
+	// Deployers which are no longer selected in the spec are uninstalled.
+	// +optional
+	Deployers []string `json:"deployers,omitempty"`
+	// Recommendations are the resource requests which the vertical pod autoscalers recommend for the components
+	// of the Landscaper instance. They are only published if vertical scaling is configured in the provider config.
+	// +optional
+	Recommendations []ResourceRecommendation `json:"recommendations,omitempty"`
+}
+
+// ResourceRecommendation are the recommended resource requests of a container of a component of a Landscaper instance.
+type ResourceRecommendation struct {
+	// Component is the name of the deployment of the component.
+	Component string `json:"component"`
+	// Container is the name of the container.
+	Container string `json:"container"`
+	// Target are the recommended resource requests.
+	// +optional
+	Target corev1.ResourceList `json:"target,omitempty"`
+	// LowerBound are the minimum resource requests with which the container still runs well.
+	// +optional
+	LowerBound corev1.ResourceList `json:"lowerBound,omitempty"`
+	// UpperBound are the resource requests beyond which additional resources are likely wasted.
+	// +optional
+	UpperBound corev1.ResourceList `json:"upperBound,omitempty"`
+}
+
+// DNSStatus describes the hostnames under which the webhooks server of a Landscaper instance is published.
+type DNSStatus struct {
+	// Scope is the scope of the hostname with which the webhooks server is registered in the MCP cluster.
+	// +optional
+	Scope DNSScope `json:"scope,omitempty"`
+
+	// BaseDomain is the base domain of the gateway from which the hostname is derived.
+	// +optional
+	BaseDomain string `json:"baseDomain,omitempty"`
+
+	// HostName is the hostname with which the webhooks server is registered in the MCP cluster.
+	// +optional
+	HostName string `json:"hostName,omitempty"`
+
+	// HostNames are all hostnames under which the webhooks server is published, one per base domain of the gateway.
+	// +optional
+	HostNames []DNSHostName `json:"hostNames,omitempty"`
+
+	// Migration is set while the instance is migrated from a previous base domain to the current one.
+	// +optional
+	Migration *DNSMigrationStatus `json:"migration,omitempty"`
+}
+
+// DNSHostName is a hostname under which the webhooks server is published.
+type DNSHostName struct {
+	// Scope is the scope of the hostname.
+	Scope DNSScope `json:"scope"`
+
+	// BaseDomain is the base domain of the gateway from which the hostname is derived.
+	BaseDomain string `json:"baseDomain"`
+
+	// HostName is the hostname of the webhooks server.
+	HostName string `json:"hostName"`
+}
+
+// DNSMigrationStatus describes an ongoing migration of the webhooks server hostname to a new base domain.
+type DNSMigrationStatus struct {
+	// PreviousBaseDomain is the base domain from which the instance is migrated.
+	PreviousBaseDomain string `json:"previousBaseDomain"`
+
+	// PreviousHostName is the hostname from which the instance is migrated.
+	// It remains routed until the migration has finished.
+	PreviousHostName string `json:"previousHostName"`
+
+	// Phase is the current phase of the migration.
+	Phase DNSMigrationPhase `json:"phase"`
+
+	// LastTransitionTime is the time when the migration entered the current phase.
+	LastTransitionTime metav1.Time `json:"lastTransitionTime"`
 }
 
 // +kubebuilder:object:root=true
@@ -17,18 +17,35 @@ limitations under the License.
 package v1alpha2
 
 import (
-	"github.com/openmcp-project/openmcp-operator/api/common"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
 const (
-	LandscaperComponentPrefix         = "github.com/openmcp-project/landscaper"
-	LandscaperControllerImageLocation = LandscaperComponentPrefix + "/images/landscaper-controller"
-	LandscaperWebhooksImageLocations  = LandscaperComponentPrefix + "/images/landscaper-webhooks-server"
-	HelmDeployerImageLocation         = LandscaperComponentPrefix + "/helm-deployer/images/helm-deployer-controller"
-	ManifestDeployerImageLocation     = LandscaperComponentPrefix + "/manifest-deployer/images/manifest-deployer-controller"
+	LandscaperComponentPrefix          = "github.com/openmcp-project/landscaper"
+	LandscaperControllerImageLocation  = LandscaperComponentPrefix + "/images/landscaper-controller"
+	LandscaperWebhooksImageLocations   = LandscaperComponentPrefix + "/images/landscaper-webhooks-server"
+	HelmDeployerImageLocation          = LandscaperComponentPrefix + "/helm-deployer/images/helm-deployer-controller"
+	ManifestDeployerImageLocation      = LandscaperComponentPrefix + "/manifest-deployer/images/manifest-deployer-controller"
+	ContainerDeployerImageLocation     = LandscaperComponentPrefix + "/container-deployer/images/container-deployer-controller"
+	ContainerDeployerInitImageLocation = LandscaperComponentPrefix + "/container-deployer/images/container-deployer-init"
+	ContainerDeployerWaitImageLocation = LandscaperComponentPrefix + "/container-deployer/images/container-deployer-wait"
+	MockDeployerImageLocation          = LandscaperComponentPrefix + "/mock-deployer/images/mock-deployer-controller"
+)
+
+const (
+	// DeployerContainer is the name of the Landscaper container deployer.
+	DeployerContainer = "container"
+	// DeployerMock is the name of the Landscaper mock deployer.
+	DeployerMock = "mock"
+	// DeployerHelm and DeployerManifest are the names of the deployers that are installed for every Landscaper instance.
+	// They are reserved and cannot be offered as additional deployers.
+	DeployerHelm     = "helm"
+	DeployerManifest = "manifest"
 )
 
 // ProviderConfigSpec is the specification of the Landscaper Service Provider configuration
@@ -38,7 +55,375 @@ type ProviderConfigSpec struct {
 	// CABundleRef is a reference to a config map containing a PEM-encoded certificate bundle.
 	// It will be installed on the OpenControlPlane and configured for the domain service.
 	// +kubebuilder:validation:Optional
-	CABundleRef *corev1.ConfigMapKeySelector `json:"caBundleRef,omitempty"`
+	CABundleRef *SourceKeyReference `json:"caBundleRef,omitempty"`
+	// RegistrySecrets are secrets with OCI registry credentials, by default in the namespace of the service provider on the platform cluster.
+	// Landscaper resources can reference them by name with source Platform as registry credentials.
+	// +kubebuilder:validation:Optional
+	RegistrySecrets []SourceReference `json:"registrySecrets,omitempty"`
+	// Registry configures the access of all Landscaper instances to OCI registries.
+	// Landscaper resources can add further registries.
+	// +kubebuilder:validation:Optional
+	Registry *ProviderRegistrySpec `json:"registry,omitempty"`
+	// ConfigurationBounds limits the configuration of the controllers which Landscaper resources can set.
+	// +kubebuilder:validation:Optional
+	ConfigurationBounds *ConfigurationBounds `json:"configurationBounds,omitempty"`
+	// Scheduling configures the scheduling of the pods of all Landscaper instances on the workload cluster.
+	// +kubebuilder:validation:Optional
+	Scheduling *SchedulingSpec `json:"scheduling,omitempty"`
+	// Security configures the security contexts of the pods of all Landscaper instances on the workload cluster.
+	// +kubebuilder:validation:Optional
+	Security *SecuritySpec `json:"security,omitempty"`
+	// NetworkPolicies configures the network policies which isolate the namespaces of the Landscaper instances
+	// on the workload cluster.
+	// +kubebuilder:validation:Optional
+	NetworkPolicies *NetworkPoliciesSpec `json:"networkPolicies,omitempty"`
+	// ResourceQuota configures the resource quota and limit range in the namespaces of the Landscaper instances
+	// on the workload cluster.
+	// +kubebuilder:validation:Optional
+	ResourceQuota *ResourceQuotaSpec `json:"resourceQuota,omitempty"`
+	// VerticalScaling creates vertical pod autoscalers for the components of all Landscaper instances on the workload cluster.
+	// It requires the vertical pod autoscaler to be installed on the workload cluster.
+	// +kubebuilder:validation:Optional
+	VerticalScaling *VerticalScalingSpec `json:"verticalScaling,omitempty"`
+	// HorizontalScaling configures the horizontal pod autoscalers of the components of all Landscaper instances
+	// on the workload cluster. Components without settings keep their default autoscalers.
+	// +kubebuilder:validation:Optional
+	HorizontalScaling *HorizontalScalingSpec `json:"horizontalScaling,omitempty"`
+}
+
+// HorizontalScalingSpec configures the horizontal pod autoscalers of the components of the Landscaper instances.
+// The central Landscaper controller is not scaled. The autoscalers of additional deployers are configured in their offerings.
+type HorizontalScalingSpec struct {
+	// LandscaperController configures the autoscaler of the main Landscaper controller.
+	// +kubebuilder:validation:Optional
+	LandscaperController *HorizontalPodAutoscalerSpec `json:"landscaperController,omitempty"`
+	// WebhooksServer configures the autoscaler of the Landscaper webhooks server.
+	// +kubebuilder:validation:Optional
+	WebhooksServer *HorizontalPodAutoscalerSpec `json:"webhooksServer,omitempty"`
+	// HelmDeployer configures the autoscaler of the helm deployer.
+	// +kubebuilder:validation:Optional
+	HelmDeployer *HorizontalPodAutoscalerSpec `json:"helmDeployer,omitempty"`
+	// ManifestDeployer configures the autoscaler of the manifest deployer.
+	// +kubebuilder:validation:Optional
+	ManifestDeployer *HorizontalPodAutoscalerSpec `json:"manifestDeployer,omitempty"`
+}
+
+// HorizontalPodAutoscalerSpec configures the horizontal pod autoscaler of a component.
+// Unset fields keep the defaults of the component.
+type HorizontalPodAutoscalerSpec struct {
+	// MinReplicas is the minimum number of replicas. Defaults to 2 for the webhooks server and to 1 for the other components.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	MinReplicas *int32 `json:"minReplicas,omitempty"`
+	// MaxReplicas is the maximum number of replicas. Defaults to 2 for the webhooks server and to 1 for the other components.
+	// The maximum replicas of a deployer which are set in a Landscaper resource take precedence.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	MaxReplicas *int32 `json:"maxReplicas,omitempty"`
+	// AverageCPUUtilization is the target of the average CPU utilization in percent. Defaults to 80 if no metrics are set.
+	// With metrics, the CPU utilization is only a target if it is set.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	AverageCPUUtilization *int32 `json:"averageCPUUtilization,omitempty"`
+	// AverageMemoryUtilization is the target of the average memory utilization in percent. Defaults to 80 if no metrics are set.
+	// With metrics, the memory utilization is only a target if it is set.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	AverageMemoryUtilization *int32 `json:"averageMemoryUtilization,omitempty"`
+	// Behavior configures the scaling behavior. Defaults to the behavior of Kubernetes.
+	// +kubebuilder:validation:Optional
+	Behavior *autoscalingv2.HorizontalPodAutoscalerBehavior `json:"behavior,omitempty"`
+	// Metrics are additional metric targets, for example custom or external metrics with the number of queued
+	// Installations and DeployItems. They are added to the CPU and memory targets which are set.
+	// +kubebuilder:validation:Optional
+	Metrics []autoscalingv2.MetricSpec `json:"metrics,omitempty"`
+}
+
+// VerticalScalingUpdateMode is the update mode of the vertical pod autoscalers.
+// +kubebuilder:validation:Enum=Off;Initial;Recreate;InPlaceOrRecreate
+type VerticalScalingUpdateMode string
+
+const (
+	// VerticalScalingUpdateModeOff only computes recommendations, without changing the resource requests of the pods.
+	VerticalScalingUpdateModeOff VerticalScalingUpdateMode = "Off"
+)
+
+// VerticalScalingSpec configures the vertical pod autoscalers of the components of the Landscaper instances.
+type VerticalScalingSpec struct {
+	// UpdateMode is the update mode of the vertical pod autoscalers. With Off, they only compute recommendations,
+	// which are published in the status of the Landscaper resources. The other modes apply the recommendations to the pods.
+	// Defaults to Off.
+	// +kubebuilder:validation:Optional
+	UpdateMode VerticalScalingUpdateMode `json:"updateMode,omitempty"`
+	// MinAllowed is the lower bound of the recommended resource requests of each container.
+	// +kubebuilder:validation:Optional
+	MinAllowed corev1.ResourceList `json:"minAllowed,omitempty"`
+	// MaxAllowed is the upper bound of the recommended resource requests of each container.
+	// If the recommendations are applied, the resource quota of the instances is derived from it.
+	// +kubebuilder:validation:Optional
+	MaxAllowed corev1.ResourceList `json:"maxAllowed,omitempty"`
+}
+
+// ResourceQuotaSpec configures the resource quota and limit range in the namespaces of the Landscaper instances.
+// The quota is derived from the resource requests and the maximum number of replicas of the components of an instance.
+type ResourceQuotaSpec struct {
+	// Disabled skips the resource quota and limit range, and removes them if they have been created before.
+	// +kubebuilder:validation:Optional
+	Disabled bool `json:"disabled,omitempty"`
+	// Headroom is added to the derived quota, for example for the pods of the deploy items of the container deployer.
+	// Its keys are the resource names of a resource quota, like requests.cpu, requests.memory or pods.
+	// Defaults to 10 pods, 1 CPU and 1Gi memory for instances with the container deployer, otherwise to none.
+	// +kubebuilder:validation:Optional
+	Headroom corev1.ResourceList `json:"headroom,omitempty"`
+	// DefaultRequest are the resource requests of containers without requests. Defaults to 10m CPU and 32Mi memory.
+	// +kubebuilder:validation:Optional
+	DefaultRequest corev1.ResourceList `json:"defaultRequest,omitempty"`
+}
+
+// NetworkPoliciesSpec configures the network policies in the namespaces of the Landscaper instances.
+// By default, all traffic of the pods in a namespace is denied, except for the rules configured here.
+type NetworkPoliciesSpec struct {
+	// Disabled skips the network policies, and removes them if they have been created before.
+	// +kubebuilder:validation:Optional
+	Disabled bool `json:"disabled,omitempty"`
+	// Gateway are the peers which may reach the webhooks server on its service port.
+	// Defaults to the pods in the namespace of the gateway.
+	// +kubebuilder:validation:Optional
+	Gateway []networkingv1.NetworkPolicyPeer `json:"gateway,omitempty"`
+	// DNS replaces the default egress rule for DNS, which allows port 53 of the kube-dns pods in the namespace kube-system.
+	// +kubebuilder:validation:Optional
+	DNS *networkingv1.NetworkPolicyEgressRule `json:"dns,omitempty"`
+	// APIServers replaces the default egress rule for the API servers of the OpenControlPlane and the workload cluster,
+	// which allows the ports 443 and 6443 of all destinations.
+	// +kubebuilder:validation:Optional
+	APIServers *networkingv1.NetworkPolicyEgressRule `json:"apiServers,omitempty"`
+	// Registries replaces the default egress rule for the configured OCI registries, which allows port 443 of all destinations.
+	// +kubebuilder:validation:Optional
+	Registries *networkingv1.NetworkPolicyEgressRule `json:"registries,omitempty"`
+	// AdditionalEgress are further egress rules for all pods in the namespaces, for example to the targets of deploy items.
+	// +kubebuilder:validation:Optional
+	AdditionalEgress []networkingv1.NetworkPolicyEgressRule `json:"additionalEgress,omitempty"`
+	// AdditionalIngress are further ingress rules for all pods in the namespaces, for example for the scraping of metrics.
+	// +kubebuilder:validation:Optional
+	AdditionalIngress []networkingv1.NetworkPolicyIngressRule `json:"additionalIngress,omitempty"`
+}
+
+// PodSecurityLevel is a level of the Pod Security Standards.
+// +kubebuilder:validation:Enum=privileged;baseline;restricted
+type PodSecurityLevel string
+
+const (
+	PodSecurityLevelPrivileged PodSecurityLevel = "privileged"
+	PodSecurityLevelBaseline   PodSecurityLevel = "baseline"
+	PodSecurityLevelRestricted PodSecurityLevel = "restricted"
+)
+
+// SecuritySpec configures the security contexts of the pods of the Landscaper controllers, the webhooks server and the deployers.
+// By default, they comply with the restricted Pod Security Standard.
+type SecuritySpec struct {
+	// PodSecurityContext replaces the default security context of the pods.
+	// +kubebuilder:validation:Optional
+	PodSecurityContext *corev1.PodSecurityContext `json:"podSecurityContext,omitempty"`
+	// SecurityContext replaces the default security context of the containers.
+	// +kubebuilder:validation:Optional
+	SecurityContext *corev1.SecurityContext `json:"securityContext,omitempty"`
+	// EnforceLevel is the Pod Security Standard which is enforced in the namespaces of the Landscaper instances.
+	// Defaults to restricted, or to baseline for instances with the container deployer, whose deploy items
+	// run their own pods in the namespace.
+	// +kubebuilder:validation:Optional
+	EnforceLevel PodSecurityLevel `json:"enforceLevel,omitempty"`
+}
+
+// GetPodSecurityContext returns the configured pod security context, or nil if the default applies.
+func (s *SecuritySpec) GetPodSecurityContext() *corev1.PodSecurityContext {
+	if s == nil {
+		return nil
+	}
+	return s.PodSecurityContext
+}
+
+// GetSecurityContext returns the configured container security context, or nil if the default applies.
+func (s *SecuritySpec) GetSecurityContext() *corev1.SecurityContext {
+	if s == nil {
+		return nil
+	}
+	return s.SecurityContext
+}
+
+// SchedulingSpec configures the scheduling of the pods of the Landscaper controllers, the webhooks server and the deployers.
+type SchedulingSpec struct {
+	// NodeSelector restricts the pods to nodes with these labels.
+	// +kubebuilder:validation:Optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+	// Tolerations allow the pods to be scheduled on nodes with matching taints.
+	// +kubebuilder:validation:Optional
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+	// Affinity are the affinity rules of the pods.
+	// +kubebuilder:validation:Optional
+	Affinity *corev1.Affinity `json:"affinity,omitempty"`
+	// PriorityClassName is the priority class of the pods.
+	// +kubebuilder:validation:Optional
+	PriorityClassName string `json:"priorityClassName,omitempty"`
+	// TopologySpreadConstraints replace the default constraints, which spread the pods of each component
+	// across zones and nodes. Each constraint applies to the pods of one component.
+	// +kubebuilder:validation:Optional
+	TopologySpreadConstraints []TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
+}
+
+// TopologySpreadConstraint spreads the pods of a component across a topology domain.
+type TopologySpreadConstraint struct {
+	// TopologyKey is the key of the node labels which defines the topology domains.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	TopologyKey string `json:"topologyKey"`
+	// MaxSkew is the maximum difference in the number of pods between two topology domains. Defaults to 1.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	MaxSkew int32 `json:"maxSkew,omitempty"`
+	// WhenUnsatisfiable defines how to deal with a pod that does not satisfy the constraint. Defaults to ScheduleAnyway.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=DoNotSchedule;ScheduleAnyway
+	WhenUnsatisfiable corev1.UnsatisfiableConstraintAction `json:"whenUnsatisfiable,omitempty"`
+}
+
+// ConfigurationBounds limits the configuration of the controllers which Landscaper resources can set.
+type ConfigurationBounds struct {
+	// MaxWorkers is the maximum number of workers of each controller, including the helm and manifest deployer.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	MaxWorkers *int32 `json:"maxWorkers,omitempty"`
+	// MaxReplicas is the maximum number of replicas to which Landscaper resources can scale the helm and manifest deployer.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	MaxReplicas *int32 `json:"maxReplicas,omitempty"`
+	// MinDeployItemTimeout is the minimum of the pickup and abort timeouts of deploy items.
+	// +kubebuilder:validation:Optional
+	MinDeployItemTimeout *metav1.Duration `json:"minDeployItemTimeout,omitempty"`
+	// MaxDeployItemTimeout is the maximum of the pickup and abort timeouts of deploy items.
+	// +kubebuilder:validation:Optional
+	MaxDeployItemTimeout *metav1.Duration `json:"maxDeployItemTimeout,omitempty"`
+}
+
+// IsRegistrySecret returns true if the secret with the given name is offered as registry secret.
+func (s *ProviderConfigSpec) IsRegistrySecret(name string) bool {
+	return s.GetRegistrySecret(name) != nil
+}
+
+// GetRegistrySecret returns the reference of the registry secret which is offered with the given name, or nil if there is none.
+func (s *ProviderConfigSpec) GetRegistrySecret(name string) *SourceReference {
+	for i := range s.RegistrySecrets {
+		if s.RegistrySecrets[i].Name == name {
+			return &s.RegistrySecrets[i]
+		}
+	}
+	return nil
+}
+
+// Source is the location of a secret or config map which the provider reads and syncs to the workload cluster.
+// +kubebuilder:validation:Enum=Platform;Onboarding;External
+type Source string
+
+const (
+	// SourcePlatform is a namespace on the platform cluster, by default the namespace of the service provider.
+	SourcePlatform Source = "Platform"
+	// SourceOnboarding is the namespace of the Landscaper resource on the onboarding cluster.
+	// Every tenant provides its own secret or config map with the referenced name.
+	SourceOnboarding Source = "Onboarding"
+	// SourceExternal is the external secret store of the service provider, which is configured in the run command.
+	SourceExternal Source = "External"
+)
+
+// SourceReference references a secret or config map which the provider reads and syncs to the workload cluster.
+type SourceReference struct {
+	// Name is the name of the secret or config map.
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Namespace is the namespace on the platform cluster. It is only allowed with source Platform,
+	// and defaults to the namespace of the service provider.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// Source is the location of the secret or config map. Defaults to Platform.
+	// +optional
+	Source Source `json:"source,omitempty"`
+}
+
+// GetSource returns the location of the secret or config map, defaulting to Platform.
+func (r *SourceReference) GetSource() Source {
+	if r.Source == "" {
+		return SourcePlatform
+	}
+	return r.Source
+}
+
+// SourceKeyReference references a key of a secret or config map which the provider reads and syncs to the workload cluster.
+type SourceKeyReference struct {
+	SourceReference `json:",inline"`
+
+	// Key is the key in the secret or config map.
+	// +kubebuilder:validation:MinLength=1
+	Key string `json:"key"`
+}
+
+// ProviderRegistrySpec configures the access of all Landscaper instances to OCI registries.
+type ProviderRegistrySpec struct {
+	// Registries configures the access to single OCI registries.
+	// The secrets of the registries are read from their source, like the other references of the ProviderConfig.
+	// +kubebuilder:validation:Optional
+	// +listType=map
+	// +listMapKey=host
+	Registries []OCIRegistry `json:"registries,omitempty"`
+	// Cache configures the cache of the Landscaper for OCI artifacts.
+	// +kubebuilder:validation:Optional
+	Cache *OCICacheSpec `json:"cache,omitempty"`
+}
+
+// GetRegistries returns the configured OCI registries.
+func (r *ProviderRegistrySpec) GetRegistries() []OCIRegistry {
+	if r == nil {
+		return nil
+	}
+	return r.Registries
+}
+
+// GetCache returns the cache configuration, or nil if none is configured.
+func (r *ProviderRegistrySpec) GetCache() *OCICacheSpec {
+	if r == nil {
+		return nil
+	}
+	return r.Cache
+}
+
+// OCICacheSpec configures the cache of the Landscaper for OCI artifacts.
+type OCICacheSpec struct {
+	// UseInMemoryOverlay additionally caches OCI artifacts in memory.
+	// +kubebuilder:validation:Optional
+	UseInMemoryOverlay bool `json:"useInMemoryOverlay,omitempty"`
+	// SizeLimit limits the size of the emptyDir cache volume.
+	// +kubebuilder:validation:Optional
+	SizeLimit *resource.Quantity `json:"sizeLimit,omitempty"`
+	// Medium is the storage medium of the emptyDir cache volume.
+	// With Memory, the cache is a tmpfs, which counts against the memory limit of the container.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum="";Memory
+	Medium corev1.StorageMedium `json:"medium,omitempty"`
+	// Persistent backs the cache of the main controller with a persistent volume claim,
+	// so that the cache survives restarts and rollouts. SizeLimit and Medium do not apply to it.
+	// As the claim can only be attached to one node, the main controller is limited to one replica.
+	// +kubebuilder:validation:Optional
+	Persistent *PersistentCacheSpec `json:"persistent,omitempty"`
+}
+
+// PersistentCacheSpec configures the persistent volume claim of the cache.
+type PersistentCacheSpec struct {
+	// StorageClassName is the storage class of the persistent volume claim.
+	// Defaults to the default storage class of the workload cluster. It only applies when the claim is created.
+	// +kubebuilder:validation:Optional
+	StorageClassName *string `json:"storageClassName,omitempty"`
+	// Size is the requested storage size. It can only be increased if the storage class allows volume expansion.
+	// +kubebuilder:validation:Required
+	Size resource.Quantity `json:"size"`
 }
 
 // ProviderConfigStatus is the status of the Landscaper Service Provider configuration
@@ -75,13 +460,55 @@ type Deployment struct {
 	// ManifestDeployer allows to override the image location of the landscaper manifest deployer manually
 	// +optional
 	ManifestDeployer *ImageConfiguration `json:"manifestDeployer,omitempty"`
+
+	// Deployers are the additional deployers which Landscaper instances can select in their spec.
+	// The helm and manifest deployer are installed by default and must not be listed here.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	Deployers []DeployerOffering `json:"deployers,omitempty"`
+}
+
+// DeployerOffering describes an additional deployer which Landscaper instances can select.
+type DeployerOffering struct {
+	// Name is the name with which Landscaper instances select the deployer.
+	// The names "container" and "mock" refer to the container and mock deployer of the Landscaper.
+	// Any other name refers to a custom deployer, for which Image is required.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=20
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	Name string `json:"name"`
+	// Image allows to override the image location of the deployer controller.
+	// Custom deployer images are used as they are, without appending the Landscaper version.
+	// +optional
+	Image *ImageConfiguration `json:"image,omitempty"`
+	// InitImage allows to override the image location of the init container of the container deployer.
+	// +optional
+	InitImage *ImageConfiguration `json:"initImage,omitempty"`
+	// WaitImage allows to override the image location of the wait container of the container deployer.
+	// +optional
+	WaitImage *ImageConfiguration `json:"waitImage,omitempty"`
+	// Configuration is the configuration file of a custom deployer.
+	// +optional
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +kubebuilder:validation:Schemaless
+	Configuration *runtime.RawExtension `json:"configuration,omitempty"`
+	// HorizontalScaling configures the horizontal pod autoscaler of the deployer.
+	// +optional
+	HorizontalScaling *HorizontalPodAutoscalerSpec `json:"horizontalScaling,omitempty"`
+}
+
+// IsBuiltin returns true if the deployer is the container or mock deployer of the Landscaper.
+func (d *DeployerOffering) IsBuiltin() bool {
+	return d.Name == DeployerContainer || d.Name == DeployerMock
 }
 
 type ImageConfiguration struct {
 	// +kubebuilder:validation:Required
 	// +kubebuilder:validation:MinLength=1
-	Image            string                        `json:"image"`
-	ImagePullSecrets []common.LocalObjectReference `json:"imagePullSecrets,omitempty"`
+	Image            string            `json:"image"`
+	ImagePullSecrets []SourceReference `json:"imagePullSecrets,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -113,6 +540,16 @@ func (d *Deployment) IsVersionAvailable(version string) bool {
 	return false
 }
 
+// GetDeployerOffering returns the additional deployer with the given name, or nil if it is not offered.
+func (d *Deployment) GetDeployerOffering(name string) *DeployerOffering {
+	for i := range d.Deployers {
+		if d.Deployers[i].Name == name {
+			return &d.Deployers[i]
+		}
+	}
+	return nil
+}
+
 func (pc *ProviderConfig) GetLandscaperControllerImageLocation(version string) string {
 	if pc.Spec.Deployment.LandscaperController != nil {
 		return imageWithVersion(pc.Spec.Deployment.LandscaperController.Image, version)
@@ -145,6 +582,39 @@ func (pc *ProviderConfig) GetManifestDeployerImageLocation(version string) strin
 	return imageWithVersion(pc.Spec.Deployment.Repository+"/"+ManifestDeployerImageLocation, version)
 }
 
+// GetDeployerImageLocation returns the image location of the controller of an additional deployer.
+// It returns an empty string for a custom deployer without image.
+func (pc *ProviderConfig) GetDeployerImageLocation(offering *DeployerOffering, version string) string {
+	switch {
+	case offering.Image != nil && offering.IsBuiltin():
+		return imageWithVersion(offering.Image.Image, version)
+	case offering.Image != nil:
+		return offering.Image.Image
+	case offering.Name == DeployerContainer:
+		return imageWithVersion(pc.Spec.Deployment.Repository+"/"+ContainerDeployerImageLocation, version)
+	case offering.Name == DeployerMock:
+		return imageWithVersion(pc.Spec.Deployment.Repository+"/"+MockDeployerImageLocation, version)
+	default:
+		return ""
+	}
+}
+
+func (pc *ProviderConfig) GetContainerDeployerInitImageLocation(offering *DeployerOffering, version string) string {
+	if offering.InitImage != nil {
+		return imageWithVersion(offering.InitImage.Image, version)
+	}
+
+	return imageWithVersion(pc.Spec.Deployment.Repository+"/"+ContainerDeployerInitImageLocation, version)
+}
+
+func (pc *ProviderConfig) GetContainerDeployerWaitImageLocation(offering *DeployerOffering, version string) string {
+	if offering.WaitImage != nil {
+		return imageWithVersion(offering.WaitImage.Image, version)
+	}
+
+	return imageWithVersion(pc.Spec.Deployment.Repository+"/"+ContainerDeployerWaitImageLocation, version)
+}
+
 func imageWithVersion(image, version string) string {
 	return image + ":" + version
 }
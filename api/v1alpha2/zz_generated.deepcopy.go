@@ -5,12 +5,291 @@
 package v1alpha2
 
 import (
-	"github.com/openmcp-project/openmcp-operator/api/common"
+	"k8s.io/api/autoscaling/v2"
 	v1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigurationBounds) DeepCopyInto(out *ConfigurationBounds) {
+	*out = *in
+	if in.MaxWorkers != nil {
+		in, out := &in.MaxWorkers, &out.MaxWorkers
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxReplicas != nil {
+		in, out := &in.MaxReplicas, &out.MaxReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MinDeployItemTimeout != nil {
+		in, out := &in.MinDeployItemTimeout, &out.MinDeployItemTimeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.MaxDeployItemTimeout != nil {
+		in, out := &in.MaxDeployItemTimeout, &out.MaxDeployItemTimeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigurationBounds.
+func (in *ConfigurationBounds) DeepCopy() *ConfigurationBounds {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigurationBounds)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ControllerConfiguration) DeepCopyInto(out *ControllerConfiguration) {
+	*out = *in
+	if in.DeployItemTimeouts != nil {
+		in, out := &in.DeployItemTimeouts, &out.DeployItemTimeouts
+		*out = new(DeployItemTimeouts)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ExcludedNamespaces != nil {
+		in, out := &in.ExcludedNamespaces, &out.ExcludedNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Workers != nil {
+		in, out := &in.Workers, &out.Workers
+		*out = new(ControllerWorkers)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControllerConfiguration.
+func (in *ControllerConfiguration) DeepCopy() *ControllerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ControllerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ControllerWorkers) DeepCopyInto(out *ControllerWorkers) {
+	*out = *in
+	if in.Installations != nil {
+		in, out := &in.Installations, &out.Installations
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Executions != nil {
+		in, out := &in.Executions, &out.Executions
+		*out = new(int32)
+		**out = **in
+	}
+	if in.DeployItems != nil {
+		in, out := &in.DeployItems, &out.DeployItems
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Contexts != nil {
+		in, out := &in.Contexts, &out.Contexts
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControllerWorkers.
+func (in *ControllerWorkers) DeepCopy() *ControllerWorkers {
+	if in == nil {
+		return nil
+	}
+	out := new(ControllerWorkers)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSHostName) DeepCopyInto(out *DNSHostName) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSHostName.
+func (in *DNSHostName) DeepCopy() *DNSHostName {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSHostName)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSMigrationStatus) DeepCopyInto(out *DNSMigrationStatus) {
+	*out = *in
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSMigrationStatus.
+func (in *DNSMigrationStatus) DeepCopy() *DNSMigrationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSMigrationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSStatus) DeepCopyInto(out *DNSStatus) {
+	*out = *in
+	if in.HostNames != nil {
+		in, out := &in.HostNames, &out.HostNames
+		*out = make([]DNSHostName, len(*in))
+		copy(*out, *in)
+	}
+	if in.Migration != nil {
+		in, out := &in.Migration, &out.Migration
+		*out = new(DNSMigrationStatus)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSStatus.
+func (in *DNSStatus) DeepCopy() *DNSStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeployItemTimeouts) DeepCopyInto(out *DeployItemTimeouts) {
+	*out = *in
+	if in.Pickup != nil {
+		in, out := &in.Pickup, &out.Pickup
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.Abort != nil {
+		in, out := &in.Abort, &out.Abort
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployItemTimeouts.
+func (in *DeployItemTimeouts) DeepCopy() *DeployItemTimeouts {
+	if in == nil {
+		return nil
+	}
+	out := new(DeployItemTimeouts)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeployerConfiguration) DeepCopyInto(out *DeployerConfiguration) {
+	*out = *in
+	if in.TargetSelectors != nil {
+		in, out := &in.TargetSelectors, &out.TargetSelectors
+		*out = make([]TargetSelector, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DefaultExportTimeout != nil {
+		in, out := &in.DefaultExportTimeout, &out.DefaultExportTimeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.Workers != nil {
+		in, out := &in.Workers, &out.Workers
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxReplicas != nil {
+		in, out := &in.MaxReplicas, &out.MaxReplicas
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployerConfiguration.
+func (in *DeployerConfiguration) DeepCopy() *DeployerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(DeployerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeployerOffering) DeepCopyInto(out *DeployerOffering) {
+	*out = *in
+	if in.Image != nil {
+		in, out := &in.Image, &out.Image
+		*out = new(ImageConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.InitImage != nil {
+		in, out := &in.InitImage, &out.InitImage
+		*out = new(ImageConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.WaitImage != nil {
+		in, out := &in.WaitImage, &out.WaitImage
+		*out = new(ImageConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Configuration != nil {
+		in, out := &in.Configuration, &out.Configuration
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HorizontalScaling != nil {
+		in, out := &in.HorizontalScaling, &out.HorizontalScaling
+		*out = new(HorizontalPodAutoscalerSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployerOffering.
+func (in *DeployerOffering) DeepCopy() *DeployerOffering {
+	if in == nil {
+		return nil
+	}
+	out := new(DeployerOffering)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeployerSpec) DeepCopyInto(out *DeployerSpec) {
+	*out = *in
+	if in.Configuration != nil {
+		in, out := &in.Configuration, &out.Configuration
+		*out = new(DeployerConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployerSpec.
+func (in *DeployerSpec) DeepCopy() *DeployerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DeployerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Deployment) DeepCopyInto(out *Deployment) {
 	*out = *in
@@ -39,6 +318,13 @@ func (in *Deployment) DeepCopyInto(out *Deployment) {
 		*out = new(ImageConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Deployers != nil {
+		in, out := &in.Deployers, &out.Deployers
+		*out = make([]DeployerOffering, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Deployment.
@@ -51,12 +337,117 @@ func (in *Deployment) DeepCopy() *Deployment {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HelmDeployerSpec) DeepCopyInto(out *HelmDeployerSpec) {
+	*out = *in
+	in.DeployerSpec.DeepCopyInto(&out.DeployerSpec)
+	if in.Registries != nil {
+		in, out := &in.Registries, &out.Registries
+		*out = make([]OCIRegistry, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HelmDeployerSpec.
+func (in *HelmDeployerSpec) DeepCopy() *HelmDeployerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(HelmDeployerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HorizontalPodAutoscalerSpec) DeepCopyInto(out *HorizontalPodAutoscalerSpec) {
+	*out = *in
+	if in.MinReplicas != nil {
+		in, out := &in.MinReplicas, &out.MinReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxReplicas != nil {
+		in, out := &in.MaxReplicas, &out.MaxReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.AverageCPUUtilization != nil {
+		in, out := &in.AverageCPUUtilization, &out.AverageCPUUtilization
+		*out = new(int32)
+		**out = **in
+	}
+	if in.AverageMemoryUtilization != nil {
+		in, out := &in.AverageMemoryUtilization, &out.AverageMemoryUtilization
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Behavior != nil {
+		in, out := &in.Behavior, &out.Behavior
+		*out = new(v2.HorizontalPodAutoscalerBehavior)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Metrics != nil {
+		in, out := &in.Metrics, &out.Metrics
+		*out = make([]v2.MetricSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HorizontalPodAutoscalerSpec.
+func (in *HorizontalPodAutoscalerSpec) DeepCopy() *HorizontalPodAutoscalerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(HorizontalPodAutoscalerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HorizontalScalingSpec) DeepCopyInto(out *HorizontalScalingSpec) {
+	*out = *in
+	if in.LandscaperController != nil {
+		in, out := &in.LandscaperController, &out.LandscaperController
+		*out = new(HorizontalPodAutoscalerSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.WebhooksServer != nil {
+		in, out := &in.WebhooksServer, &out.WebhooksServer
+		*out = new(HorizontalPodAutoscalerSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HelmDeployer != nil {
+		in, out := &in.HelmDeployer, &out.HelmDeployer
+		*out = new(HorizontalPodAutoscalerSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ManifestDeployer != nil {
+		in, out := &in.ManifestDeployer, &out.ManifestDeployer
+		*out = new(HorizontalPodAutoscalerSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HorizontalScalingSpec.
+func (in *HorizontalScalingSpec) DeepCopy() *HorizontalScalingSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(HorizontalScalingSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ImageConfiguration) DeepCopyInto(out *ImageConfiguration) {
 	*out = *in
 	if in.ImagePullSecrets != nil {
 		in, out := &in.ImagePullSecrets, &out.ImagePullSecrets
-		*out = make([]common.LocalObjectReference, len(*in))
+		*out = make([]SourceReference, len(*in))
 		copy(*out, *in)
 	}
 }
@@ -153,6 +544,36 @@ func (in *LandscaperSpec) DeepCopyInto(out *LandscaperSpec) {
 		*out = new(v1.LocalObjectReference)
 		**out = **in
 	}
+	if in.DisableWebhooks != nil {
+		in, out := &in.DisableWebhooks, &out.DisableWebhooks
+		*out = make([]Webhook, len(*in))
+		copy(*out, *in)
+	}
+	if in.Configuration != nil {
+		in, out := &in.Configuration, &out.Configuration
+		*out = new(ControllerConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Registry != nil {
+		in, out := &in.Registry, &out.Registry
+		*out = new(RegistrySpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HelmDeployer != nil {
+		in, out := &in.HelmDeployer, &out.HelmDeployer
+		*out = new(HelmDeployerSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ManifestDeployer != nil {
+		in, out := &in.ManifestDeployer, &out.ManifestDeployer
+		*out = new(DeployerSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Deployers != nil {
+		in, out := &in.Deployers, &out.Deployers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LandscaperSpec.
@@ -180,10 +601,27 @@ func (in *LandscaperStatus) DeepCopyInto(out *LandscaperStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LandscaperStatus.
-func (in *LandscaperStatus) DeepCopy() *LandscaperStatus {
+	if in.DNS != nil {
+		in, out := &in.DNS, &out.DNS
+		*out = new(DNSStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Deployers != nil {
+		in, out := &in.Deployers, &out.Deployers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Recommendations != nil {
+		in, out := &in.Recommendations, &out.Recommendations
+		*out = make([]ResourceRecommendation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LandscaperStatus.
+func (in *LandscaperStatus) DeepCopy() *LandscaperStatus {
 	if in == nil {
 		return nil
 	}
@@ -192,6 +630,123 @@ func (in *LandscaperStatus) DeepCopy() *LandscaperStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkPoliciesSpec) DeepCopyInto(out *NetworkPoliciesSpec) {
+	*out = *in
+	if in.Gateway != nil {
+		in, out := &in.Gateway, &out.Gateway
+		*out = make([]networkingv1.NetworkPolicyPeer, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DNS != nil {
+		in, out := &in.DNS, &out.DNS
+		*out = new(networkingv1.NetworkPolicyEgressRule)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.APIServers != nil {
+		in, out := &in.APIServers, &out.APIServers
+		*out = new(networkingv1.NetworkPolicyEgressRule)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Registries != nil {
+		in, out := &in.Registries, &out.Registries
+		*out = new(networkingv1.NetworkPolicyEgressRule)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AdditionalEgress != nil {
+		in, out := &in.AdditionalEgress, &out.AdditionalEgress
+		*out = make([]networkingv1.NetworkPolicyEgressRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AdditionalIngress != nil {
+		in, out := &in.AdditionalIngress, &out.AdditionalIngress
+		*out = make([]networkingv1.NetworkPolicyIngressRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkPoliciesSpec.
+func (in *NetworkPoliciesSpec) DeepCopy() *NetworkPoliciesSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkPoliciesSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OCICacheSpec) DeepCopyInto(out *OCICacheSpec) {
+	*out = *in
+	if in.SizeLimit != nil {
+		in, out := &in.SizeLimit, &out.SizeLimit
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.Persistent != nil {
+		in, out := &in.Persistent, &out.Persistent
+		*out = new(PersistentCacheSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OCICacheSpec.
+func (in *OCICacheSpec) DeepCopy() *OCICacheSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OCICacheSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OCIRegistry) DeepCopyInto(out *OCIRegistry) {
+	*out = *in
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(SourceReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OCIRegistry.
+func (in *OCIRegistry) DeepCopy() *OCIRegistry {
+	if in == nil {
+		return nil
+	}
+	out := new(OCIRegistry)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PersistentCacheSpec) DeepCopyInto(out *PersistentCacheSpec) {
+	*out = *in
+	if in.StorageClassName != nil {
+		in, out := &in.StorageClassName, &out.StorageClassName
+		*out = new(string)
+		**out = **in
+	}
+	out.Size = in.Size.DeepCopy()
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PersistentCacheSpec.
+func (in *PersistentCacheSpec) DeepCopy() *PersistentCacheSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PersistentCacheSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProviderConfig) DeepCopyInto(out *ProviderConfig) {
 	*out = *in
@@ -257,7 +812,52 @@ func (in *ProviderConfigSpec) DeepCopyInto(out *ProviderConfigSpec) {
 	in.Deployment.DeepCopyInto(&out.Deployment)
 	if in.CABundleRef != nil {
 		in, out := &in.CABundleRef, &out.CABundleRef
-		*out = new(v1.ConfigMapKeySelector)
+		*out = new(SourceKeyReference)
+		**out = **in
+	}
+	if in.RegistrySecrets != nil {
+		in, out := &in.RegistrySecrets, &out.RegistrySecrets
+		*out = make([]SourceReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.Registry != nil {
+		in, out := &in.Registry, &out.Registry
+		*out = new(ProviderRegistrySpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ConfigurationBounds != nil {
+		in, out := &in.ConfigurationBounds, &out.ConfigurationBounds
+		*out = new(ConfigurationBounds)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Scheduling != nil {
+		in, out := &in.Scheduling, &out.Scheduling
+		*out = new(SchedulingSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Security != nil {
+		in, out := &in.Security, &out.Security
+		*out = new(SecuritySpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NetworkPolicies != nil {
+		in, out := &in.NetworkPolicies, &out.NetworkPolicies
+		*out = new(NetworkPoliciesSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ResourceQuota != nil {
+		in, out := &in.ResourceQuota, &out.ResourceQuota
+		*out = new(ResourceQuotaSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.VerticalScaling != nil {
+		in, out := &in.VerticalScaling, &out.VerticalScaling
+		*out = new(VerticalScalingSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HorizontalScaling != nil {
+		in, out := &in.HorizontalScaling, &out.HorizontalScaling
+		*out = new(HorizontalScalingSpec)
 		(*in).DeepCopyInto(*out)
 	}
 }
@@ -286,3 +886,330 @@ func (in *ProviderConfigStatus) DeepCopy() *ProviderConfigStatus {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProviderRegistrySpec) DeepCopyInto(out *ProviderRegistrySpec) {
+	*out = *in
+	if in.Registries != nil {
+		in, out := &in.Registries, &out.Registries
+		*out = make([]OCIRegistry, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Cache != nil {
+		in, out := &in.Cache, &out.Cache
+		*out = new(OCICacheSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProviderRegistrySpec.
+func (in *ProviderRegistrySpec) DeepCopy() *ProviderRegistrySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ProviderRegistrySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RegistrySpec) DeepCopyInto(out *RegistrySpec) {
+	*out = *in
+	if in.Registries != nil {
+		in, out := &in.Registries, &out.Registries
+		*out = make([]OCIRegistry, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Cache != nil {
+		in, out := &in.Cache, &out.Cache
+		*out = new(OCICacheSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegistrySpec.
+func (in *RegistrySpec) DeepCopy() *RegistrySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RegistrySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceQuotaSpec) DeepCopyInto(out *ResourceQuotaSpec) {
+	*out = *in
+	if in.Headroom != nil {
+		in, out := &in.Headroom, &out.Headroom
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	if in.DefaultRequest != nil {
+		in, out := &in.DefaultRequest, &out.DefaultRequest
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceQuotaSpec.
+func (in *ResourceQuotaSpec) DeepCopy() *ResourceQuotaSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceQuotaSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceRecommendation) DeepCopyInto(out *ResourceRecommendation) {
+	*out = *in
+	if in.Target != nil {
+		in, out := &in.Target, &out.Target
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	if in.LowerBound != nil {
+		in, out := &in.LowerBound, &out.LowerBound
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	if in.UpperBound != nil {
+		in, out := &in.UpperBound, &out.UpperBound
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceRecommendation.
+func (in *ResourceRecommendation) DeepCopy() *ResourceRecommendation {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceRecommendation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SchedulingSpec) DeepCopyInto(out *SchedulingSpec) {
+	*out = *in
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = make([]v1.Toleration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Affinity != nil {
+		in, out := &in.Affinity, &out.Affinity
+		*out = new(v1.Affinity)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TopologySpreadConstraints != nil {
+		in, out := &in.TopologySpreadConstraints, &out.TopologySpreadConstraints
+		*out = make([]TopologySpreadConstraint, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SchedulingSpec.
+func (in *SchedulingSpec) DeepCopy() *SchedulingSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SchedulingSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecuritySpec) DeepCopyInto(out *SecuritySpec) {
+	*out = *in
+	if in.PodSecurityContext != nil {
+		in, out := &in.PodSecurityContext, &out.PodSecurityContext
+		*out = new(v1.PodSecurityContext)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SecurityContext != nil {
+		in, out := &in.SecurityContext, &out.SecurityContext
+		*out = new(v1.SecurityContext)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecuritySpec.
+func (in *SecuritySpec) DeepCopy() *SecuritySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SecuritySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SelectorRequirement) DeepCopyInto(out *SelectorRequirement) {
+	*out = *in
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SelectorRequirement.
+func (in *SelectorRequirement) DeepCopy() *SelectorRequirement {
+	if in == nil {
+		return nil
+	}
+	out := new(SelectorRequirement)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SourceKeyReference) DeepCopyInto(out *SourceKeyReference) {
+	*out = *in
+	out.SourceReference = in.SourceReference
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SourceKeyReference.
+func (in *SourceKeyReference) DeepCopy() *SourceKeyReference {
+	if in == nil {
+		return nil
+	}
+	out := new(SourceKeyReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SourceReference) DeepCopyInto(out *SourceReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SourceReference.
+func (in *SourceReference) DeepCopy() *SourceReference {
+	if in == nil {
+		return nil
+	}
+	out := new(SourceReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetReference) DeepCopyInto(out *TargetReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetReference.
+func (in *TargetReference) DeepCopy() *TargetReference {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetSelector) DeepCopyInto(out *TargetSelector) {
+	*out = *in
+	if in.Targets != nil {
+		in, out := &in.Targets, &out.Targets
+		*out = make([]TargetReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make([]SelectorRequirement, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make([]SelectorRequirement, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetSelector.
+func (in *TargetSelector) DeepCopy() *TargetSelector {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetSelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TopologySpreadConstraint) DeepCopyInto(out *TopologySpreadConstraint) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TopologySpreadConstraint.
+func (in *TopologySpreadConstraint) DeepCopy() *TopologySpreadConstraint {
+	if in == nil {
+		return nil
+	}
+	out := new(TopologySpreadConstraint)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VerticalScalingSpec) DeepCopyInto(out *VerticalScalingSpec) {
+	*out = *in
+	if in.MinAllowed != nil {
+		in, out := &in.MinAllowed, &out.MinAllowed
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	if in.MaxAllowed != nil {
+		in, out := &in.MaxAllowed, &out.MaxAllowed
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VerticalScalingSpec.
+func (in *VerticalScalingSpec) DeepCopy() *VerticalScalingSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VerticalScalingSpec)
+	in.DeepCopyInto(out)
+	return out
+}
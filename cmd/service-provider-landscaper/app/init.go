@@ -13,7 +13,6 @@ import (
 	"github.com/openmcp-project/openmcp-operator/lib/clusteraccess"
 	"github.com/openmcp-project/openmcp-operator/lib/utils"
 	"github.com/spf13/cobra"
-	rbacv1 "k8s.io/api/rbac/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
@@ -114,18 +113,7 @@ func (o *InitOptions) Run(ctx context.Context) error {
 		WithTimeout(30 * time.Minute)
 
 	onboardingCluster, err := clusterAccessManager.CreateAndWaitForCluster(ctx, "onboarding", clustersv1alpha1.PURPOSE_ONBOARDING,
-		onboardingScheme, []clustersv1alpha1.PermissionsRequest{
-			{
-				// TODO: define the specific permissions needed for the onboarding cluster
-				Rules: []rbacv1.PolicyRule{
-					{
-						APIGroups: []string{"*"},
-						Resources: []string{"*"},
-						Verbs:     []string{"*"},
-					},
-				},
-			},
-		})
+		onboardingScheme, onboardingInitPermissions(providerSystemNamespace))
 
 	if err != nil {
 		return fmt.Errorf("error creating/updating onboarding cluster: %w", err)
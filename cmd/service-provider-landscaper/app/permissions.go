@@ -0,0 +1,34 @@
+package app
+
+import (
+	clustersv1alpha1 "github.com/openmcp-project/openmcp-operator/api/clusters/v1alpha1"
+	rbacv1 "k8s.io/api/rbac/v1"
+
+	"github.com/openmcp-project/service-provider-landscaper/api/v1alpha2"
+	"github.com/openmcp-project/service-provider-landscaper/internal/controller"
+)
+
+// landscaperCRDName is the name of the Landscaper CRD, which is the only CRD that the provider deploys on the onboarding cluster.
+var landscaperCRDName = "landscapers." + v1alpha2.GroupVersion.Group
+
+// onboardingInitPermissions are the permissions of the init command on the onboarding cluster. The init and run command
+// request their permissions with the same access request, so that both get the same permissions, apart from the CRD
+// management: in addition to the permissions of the run command, the init command manages the Landscaper CRD.
+// Existing CRDs can only be read and updated if they are the Landscaper CRD.
+func onboardingInitPermissions(providerSystemNamespace string) []clustersv1alpha1.PermissionsRequest {
+	permissions := controller.OnboardingPermissions(providerSystemNamespace)
+	permissions[0].Rules = append(permissions[0].Rules,
+		rbacv1.PolicyRule{
+			APIGroups: []string{"apiextensions.k8s.io"},
+			Resources: []string{"customresourcedefinitions"},
+			Verbs:     []string{"create"},
+		},
+		rbacv1.PolicyRule{
+			APIGroups:     []string{"apiextensions.k8s.io"},
+			Resources:     []string{"customresourcedefinitions"},
+			ResourceNames: []string{landscaperCRDName},
+			Verbs:         []string{"get", "update", "patch"},
+		},
+	)
+	return permissions
+}
@@ -0,0 +1,109 @@
+package app
+
+import (
+	"fmt"
+	"slices"
+
+	clustersv1alpha1 "github.com/openmcp-project/openmcp-operator/api/clusters/v1alpha1"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/openmcp-project/service-provider-landscaper/internal/controller"
+)
+
+const providerSystemNamespace = "openmcp-system"
+
+// apiRequest is a request of the provider to the api server of the onboarding cluster.
+type apiRequest struct {
+	group     string
+	resource  string
+	name      string
+	namespace string
+	verb      string
+}
+
+func (r apiRequest) String() string {
+	return fmt.Sprintf("%s %s.%s %q in namespace %q", r.verb, r.resource, r.group, r.name, r.namespace)
+}
+
+// isPermitted returns true if one of the permission requests allows the api request.
+// Permissions with a namespace only apply to requests in that namespace,
+// and rules with resource names only to requests for these names.
+func isPermitted(permissions []clustersv1alpha1.PermissionsRequest, r apiRequest) bool {
+	for _, p := range permissions {
+		if p.Namespace != "" && p.Namespace != r.namespace {
+			continue
+		}
+		for _, rule := range p.Rules {
+			if len(rule.ResourceNames) > 0 && !slices.Contains(rule.ResourceNames, r.name) {
+				continue
+			}
+			if slices.Contains(rule.APIGroups, r.group) && slices.Contains(rule.Resources, r.resource) && slices.Contains(rule.Verbs, r.verb) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// requests returns the requests with the given verbs for a resource.
+func requests(group, resource, name, namespace string, verbs ...string) []apiRequest {
+	result := make([]apiRequest, 0, len(verbs))
+	for _, verb := range verbs {
+		result = append(result, apiRequest{group: group, resource: resource, name: name, namespace: namespace, verb: verb})
+	}
+	return result
+}
+
+// notPermitted returns the requests which the permissions do not allow.
+func notPermitted(permissions []clustersv1alpha1.PermissionsRequest, reqs []apiRequest) []string {
+	result := []string{}
+	for _, r := range reqs {
+		if !isPermitted(permissions, r) {
+			result = append(result, r.String())
+		}
+	}
+	return result
+}
+
+var _ = Describe("Onboarding Permissions", func() {
+	It("should not request wildcard permissions for the init command", func() {
+		for _, p := range onboardingInitPermissions(providerSystemNamespace) {
+			for _, rule := range p.Rules {
+				Expect(rule.APIGroups).ToNot(ContainElement("*"))
+				Expect(rule.Resources).ToNot(ContainElement("*"))
+				Expect(rule.Verbs).ToNot(ContainElement("*"))
+			}
+		}
+	})
+
+	It("should not allow the run command to manage CRDs", func() {
+		permissions := controller.OnboardingPermissions(providerSystemNamespace)
+		for _, verb := range []string{"get", "create", "update", "patch"} {
+			Expect(isPermitted(permissions, apiRequest{group: "apiextensions.k8s.io", resource: "customresourcedefinitions", name: landscaperCRDName, verb: verb})).To(BeFalse(), verb)
+		}
+	})
+
+	It("should grant the permissions of the run command and the management of the Landscaper CRD to the init command", func() {
+		permissions := onboardingInitPermissions(providerSystemNamespace)
+		runPermissions := controller.OnboardingPermissions(providerSystemNamespace)
+		Expect(permissions).To(HaveLen(len(runPermissions)))
+		for i := range runPermissions {
+			Expect(permissions[i].Namespace).To(Equal(runPermissions[i].Namespace))
+			Expect(permissions[i].Rules).To(ContainElements(runPermissions[i].Rules))
+		}
+		Expect(notPermitted(permissions, slices.Concat(
+			requests("apiextensions.k8s.io", "customresourcedefinitions", "", "", "create"),
+			requests("apiextensions.k8s.io", "customresourcedefinitions", landscaperCRDName, "", "get", "update", "patch"),
+		))).To(BeEmpty())
+	})
+
+	It("should only allow the init command to read and update the Landscaper CRD", func() {
+		permissions := onboardingInitPermissions(providerSystemNamespace)
+		for _, verb := range []string{"get", "update", "patch", "delete"} {
+			Expect(isPermitted(permissions, apiRequest{group: "apiextensions.k8s.io", resource: "customresourcedefinitions", name: "other.example.com", verb: verb})).To(BeFalse(), verb)
+		}
+		Expect(isPermitted(permissions, apiRequest{group: "apiextensions.k8s.io", resource: "customresourcedefinitions", name: landscaperCRDName, verb: "delete"})).To(BeFalse())
+	})
+})
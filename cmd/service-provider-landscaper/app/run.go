@@ -15,8 +15,6 @@ import (
 
 	"github.com/openmcp-project/service-provider-landscaper/api/v1alpha2"
 
-	rbacv1 "k8s.io/api/rbac/v1"
-
 	clustersv1alpha1 "github.com/openmcp-project/openmcp-operator/api/clusters/v1alpha1"
 	deploymentv1alpha1 "github.com/openmcp-project/openmcp-operator/api/provider/v1alpha1"
 	"github.com/spf13/cobra"
@@ -31,6 +29,7 @@ import (
 
 	providerscheme "github.com/openmcp-project/service-provider-landscaper/api/install"
 	controller1 "github.com/openmcp-project/service-provider-landscaper/internal/controller"
+	"github.com/openmcp-project/service-provider-landscaper/internal/shared/sources"
 )
 
 var setupLog logging.Logger
@@ -86,6 +85,13 @@ type RawRunOptions struct {
 	PprofAddr            string `json:"pprof-bind-address"`
 	SecureMetrics        bool   `json:"metrics-secure"`
 	EnableHTTP2          bool   `json:"enable-http2"`
+
+	DNSMigrationGracePeriod time.Duration `json:"dns-migration-grace-period"`
+
+	OrphanCollectionInterval time.Duration `json:"orphan-collection-interval"`
+	DeleteOrphans            bool          `json:"delete-orphans"`
+
+	ExternalSecretsDir string `json:"external-secrets-dir"`
 }
 
 func (o *RunOptions) AddFlags(cmd *cobra.Command) {
@@ -103,6 +109,11 @@ func (o *RunOptions) AddFlags(cmd *cobra.Command) {
 	cmd.Flags().StringVar(&o.MetricsCertKey, "metrics-cert-key", "tls.key", "The name of the metrics server key file.")
 	cmd.Flags().BoolVar(&o.EnableHTTP2, "enable-http2", false, "If set, HTTP/2 will be enabled for the metrics and webhook servers")
 
+	cmd.Flags().DurationVar(&o.DNSMigrationGracePeriod, "dns-migration-grace-period", controller1.DefaultDNSMigrationGracePeriod, "How long the previous webhooks hostname of an instance remains routed after the base domain of the gateway has changed.")
+	cmd.Flags().DurationVar(&o.OrphanCollectionInterval, "orphan-collection-interval", controller1.DefaultOrphanCollectionInterval, "The interval in which resources of deleted Landscaper instances are searched on the MCP and workload clusters. Set to 0 to disable the search.")
+	cmd.Flags().BoolVar(&o.DeleteOrphans, "delete-orphans", false, "If set, resources of deleted Landscaper instances are deleted. Otherwise, they are only reported in the log.")
+	cmd.Flags().StringVar(&o.ExternalSecretsDir, "external-secrets-dir", "", "The directory of the external secret store, which contains a directory with a file per key for each secret or config map, as created by mounting secrets. ProviderConfigs can only reference secrets and config maps with source External if it is set.")
+
 }
 
 func (o *RunOptions) PrintRaw(cmd *cobra.Command) {}
@@ -241,18 +252,7 @@ func (o *RunOptions) Run(ctx context.Context) error {
 		WithTimeout(30 * time.Minute)
 
 	onboardingCluster, err := clusterAccessManager.CreateAndWaitForCluster(ctx, "onboarding", clustersv1alpha1.PURPOSE_ONBOARDING,
-		onboardingScheme, []clustersv1alpha1.PermissionsRequest{
-			{
-				// TODO: define the specific permissions needed for the onboarding cluster
-				Rules: []rbacv1.PolicyRule{
-					{
-						APIGroups: []string{"*"},
-						Resources: []string{"*"},
-						Verbs:     []string{"*"},
-					},
-				},
-			},
-		})
+		onboardingScheme, controller1.OnboardingPermissions(providerSystemNamespace))
 
 	if err != nil {
 		return fmt.Errorf("error creating/updating onboarding cluster: %w", err)
@@ -260,6 +260,7 @@ func (o *RunOptions) Run(ctx context.Context) error {
 
 	mgrOptions := ctrl.Options{
 		Scheme:                  onboardingScheme,
+		Cache:                   controller1.OnboardingCacheOptions(),
 		Metrics:                 o.MetricsServerOptions,
 		HealthProbeBindAddress:  o.ProbeAddr,
 		PprofBindAddress:        o.PprofAddr,
@@ -288,16 +289,36 @@ func (o *RunOptions) Run(ctx context.Context) error {
 		return fmt.Errorf("unable to add platform cluster to manager: %w", err)
 	}
 
-	if err = (&controller1.LandscaperReconciler{
+	reconciler := &controller1.LandscaperReconciler{
 		OnboardingCluster: onboardingCluster,
 		PlatformCluster:   o.Clusters.Platform,
 		Scheme:            mgr.GetScheme(),
 		ProviderName:      o.ProviderName,
 		ProviderNamespace: providerSystemNamespace,
-	}).SetupWithManager(mgr); err != nil {
+
+		DNSMigrationGracePeriod: o.DNSMigrationGracePeriod,
+	}
+	if o.ExternalSecretsDir != "" {
+		reconciler.ExternalSecretStore = &sources.FileSource{Dir: o.ExternalSecretsDir}
+	}
+	if err = reconciler.SetupWithManager(mgr); err != nil {
 		return fmt.Errorf("unable to create controller: %w", err)
 	}
 
+	if o.OrphanCollectionInterval > 0 {
+		setupLog.Info("Adding orphan collector to manager", "interval", o.OrphanCollectionInterval, "dryRun", !o.DeleteOrphans)
+		if err = mgr.Add(&controller1.OrphanCollector{
+			PlatformCluster:       o.Clusters.Platform,
+			OnboardingCluster:     onboardingCluster,
+			ControllerName:        v1alpha2.LandscaperProviderName,
+			InstanceClusterAccess: reconciler.InstanceClusterAccess,
+			Interval:              o.OrphanCollectionInterval,
+			DryRun:                !o.DeleteOrphans,
+		}); err != nil {
+			return fmt.Errorf("unable to add orphan collector to manager: %w", err)
+		}
+	}
+
 	if o.MetricsCertWatcher != nil {
 		setupLog.Info("Adding metrics certificate watcher to manager")
 		if err := mgr.Add(o.MetricsCertWatcher); err != nil {
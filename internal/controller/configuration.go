@@ -0,0 +1,135 @@
+package controller
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/selection"
+
+	"github.com/openmcp-project/service-provider-landscaper/api/v1alpha2"
+)
+
+// validateConfiguration checks that the configuration of the controllers and of the helm and manifest deployer
+// set in the Landscaper resource lies within the bounds of the provider config.
+func validateConfiguration(ls *v1alpha2.Landscaper, providerConfig *v1alpha2.ProviderConfig) error {
+	conf := ls.Spec.Configuration
+	bounds := providerConfig.Spec.ConfigurationBounds
+	if bounds == nil {
+		return nil
+	}
+
+	type workers struct {
+		name  string
+		value *int32
+	}
+	var allWorkers, allReplicas []workers
+	if conf != nil && conf.Workers != nil {
+		allWorkers = append(allWorkers,
+			workers{"installations", conf.Workers.Installations},
+			workers{"executions", conf.Workers.Executions},
+			workers{"deployItems", conf.Workers.DeployItems},
+			workers{"contexts", conf.Workers.Contexts},
+		)
+	}
+	if dc := ls.Spec.HelmDeployer.GetConfiguration(); dc != nil {
+		allWorkers = append(allWorkers, workers{"helm deployer", dc.Workers})
+		allReplicas = append(allReplicas, workers{"helm deployer", dc.MaxReplicas})
+	}
+	if dc := ls.Spec.ManifestDeployer.GetConfiguration(); dc != nil {
+		allWorkers = append(allWorkers, workers{"manifest deployer", dc.Workers})
+		allReplicas = append(allReplicas, workers{"manifest deployer", dc.MaxReplicas})
+	}
+
+	var errs []error
+	if bounds.MaxWorkers != nil {
+		for _, w := range allWorkers {
+			if w.value != nil && *w.value > *bounds.MaxWorkers {
+				errs = append(errs, fmt.Errorf("%d %s workers exceed the maximum of %d in provider config %s",
+					*w.value, w.name, *bounds.MaxWorkers, providerConfig.Name))
+			}
+		}
+	}
+	if bounds.MaxReplicas != nil {
+		for _, r := range allReplicas {
+			if r.value != nil && *r.value > *bounds.MaxReplicas {
+				errs = append(errs, fmt.Errorf("%d %s replicas exceed the maximum of %d in provider config %s",
+					*r.value, r.name, *bounds.MaxReplicas, providerConfig.Name))
+			}
+		}
+	}
+
+	if conf != nil && conf.DeployItemTimeouts != nil {
+		timeouts := []struct {
+			name  string
+			value *metav1.Duration
+		}{
+			{"pickup", conf.DeployItemTimeouts.Pickup},
+			{"abort", conf.DeployItemTimeouts.Abort},
+		}
+		for _, t := range timeouts {
+			if t.value == nil {
+				continue
+			}
+			if bounds.MinDeployItemTimeout != nil && t.value.Duration < bounds.MinDeployItemTimeout.Duration {
+				errs = append(errs, fmt.Errorf("%s timeout %s is below the minimum of %s in provider config %s",
+					t.name, t.value.Duration, bounds.MinDeployItemTimeout.Duration, providerConfig.Name))
+			}
+			if bounds.MaxDeployItemTimeout != nil && t.value.Duration > bounds.MaxDeployItemTimeout.Duration {
+				errs = append(errs, fmt.Errorf("%s timeout %s exceeds the maximum of %s in provider config %s",
+					t.name, t.value.Duration, bounds.MaxDeployItemTimeout.Duration, providerConfig.Name))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// validateDeployerConfigurations checks the target selectors of the helm and manifest deployer configuration.
+// The deployers reject a selector whose operator does not fit its values only when they process a deploy item,
+// so such a selector is rejected before the deployers are installed.
+func validateDeployerConfigurations(ls *v1alpha2.Landscaper) error {
+	deployers := []struct {
+		name string
+		conf *v1alpha2.DeployerConfiguration
+	}{
+		{"helm deployer", ls.Spec.HelmDeployer.GetConfiguration()},
+		{"manifest deployer", ls.Spec.ManifestDeployer.GetConfiguration()},
+	}
+
+	var errs []error
+	for _, d := range deployers {
+		if d.conf == nil {
+			continue
+		}
+		for i, sel := range d.conf.TargetSelectors {
+			for _, req := range slices.Concat(sel.Annotations, sel.Labels) {
+				if err := validateSelectorRequirement(req); err != nil {
+					errs = append(errs, fmt.Errorf("target selector %d of the %s: %w", i, d.name, err))
+				}
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func validateSelectorRequirement(req v1alpha2.SelectorRequirement) error {
+	switch selection.Operator(req.Operator) {
+	case selection.In, selection.NotIn:
+		if len(req.Values) == 0 {
+			return fmt.Errorf("operator %q of key %s requires at least one value", req.Operator, req.Key)
+		}
+	case selection.Exists, selection.DoesNotExist:
+		if len(req.Values) != 0 {
+			return fmt.Errorf("operator %q of key %s allows no values", req.Operator, req.Key)
+		}
+	case selection.Equals, selection.DoubleEquals, selection.NotEquals:
+		if len(req.Values) != 1 {
+			return fmt.Errorf("operator %q of key %s requires exactly one value", req.Operator, req.Key)
+		}
+	default:
+		return fmt.Errorf("operator %q of key %s is not supported", req.Operator, req.Key)
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/openmcp-project/controller-utils/pkg/clusteraccess"
+	"github.com/openmcp-project/controller-utils/pkg/logging"
+	clustersv1alpha1 "github.com/openmcp-project/openmcp-operator/api/clusters/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/openmcp-project/service-provider-landscaper/internal/installer/instance"
+)
+
+const (
+	// healthCheckInterval is the interval in which a ready instance is reconciled to ensure that it is still healthy.
+	healthCheckInterval = 10 * time.Minute
+
+	// renewalRetryInterval is the interval in which an instance is reconciled while a token is due for renewal,
+	// for example because the token of an access request has not yet been rotated.
+	renewalRetryInterval = 30 * time.Second
+)
+
+// requeueAfterReady returns the time after which a ready instance is reconciled again. Besides the periodic health check,
+// the instance is reconciled ahead of the expiration of the tokens of its components, so that the kubeconfig secrets
+// contain valid tokens at any time. The tokens are mounted as files, which the components re-read without a restart.
+func (r *LandscaperReconciler) requeueAfterReady(ctx context.Context, req reconcile.Request, conf *instance.Configuration) time.Duration {
+	log := logging.FromContextOrPanic(ctx)
+
+	requeueAfter := healthCheckInterval
+	renew := func(renewalTime time.Time) {
+		if renewalTime.IsZero() {
+			return
+		}
+		requeueAfter = min(requeueAfter, max(time.Until(renewalTime), renewalRetryInterval))
+	}
+
+	workloadRenewalTime, err := r.workloadAccessRenewalTime(ctx, req)
+	if err != nil {
+		log.Error(err, "failed to read the expiration of the workload cluster access of the landscaper instance")
+	}
+	renew(workloadRenewalTime)
+
+	tokenRenewalTime, err := instance.TokenRenewalTime(ctx, conf)
+	if err != nil {
+		log.Error(err, "failed to read the renewal time of the tokens of the landscaper instance")
+	}
+	renew(tokenRenewalTime)
+
+	return requeueAfter
+}
+
+// workloadAccessRenewalTime returns the time at which the token of the access request for the workload cluster should be renewed.
+// The token is contained in the kubeconfigs of the components which access the workload cluster.
+// The token is rotated by the cluster provider, and taken over into the kubeconfig secrets by the next reconcile.
+// It returns the zero time if the token does not expire.
+func (r *LandscaperReconciler) workloadAccessRenewalTime(ctx context.Context, req reconcile.Request) (time.Time, error) {
+	ar, err := r.ClusterAccessReconciler.WorkloadAccessRequest(ctx, req)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if ar.Status.SecretRef == nil {
+		return time.Time{}, nil
+	}
+
+	secret := &corev1.Secret{}
+	if err := r.PlatformCluster.Client().Get(ctx, client.ObjectKey{Name: ar.Status.SecretRef.Name, Namespace: ar.Namespace}, secret); err != nil {
+		return time.Time{}, fmt.Errorf("failed to get secret of access request %s: %w", ar.Name, err)
+	}
+
+	creation, err := parseAccessTimestamp(secret.Data[clustersv1alpha1.SecretKeyCreationTimestamp])
+	if err != nil {
+		return time.Time{}, err
+	}
+	expiration, err := parseAccessTimestamp(secret.Data[clustersv1alpha1.SecretKeyExpirationTimestamp])
+	if err != nil {
+		return time.Time{}, err
+	}
+	return clusteraccess.ComputeTokenRenewalTime(creation, expiration), nil
+}
+
+// parseAccessTimestamp parses a timestamp of an access request secret, which is either given in unix seconds or in RFC3339 format.
+// It returns the zero time if the timestamp is not set.
+func parseAccessTimestamp(value []byte) (time.Time, error) {
+	if len(value) == 0 {
+		return time.Time{}, nil
+	}
+	if seconds, err := strconv.ParseInt(string(value), 10, 64); err == nil {
+		return time.Unix(seconds, 0), nil
+	}
+	t, err := time.Parse(time.RFC3339, string(value))
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid timestamp %q in access request secret: %w", string(value), err)
+	}
+	return t, nil
+}
@@ -0,0 +1,75 @@
+package controller
+
+import (
+	"errors"
+	"fmt"
+
+	core "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/openmcp-project/service-provider-landscaper/api/v1alpha2"
+	"github.com/openmcp-project/service-provider-landscaper/internal/installer/instance"
+)
+
+// validateDeployers checks that the deployers selected by the Landscaper resource are offered by the provider config.
+func validateDeployers(ls *v1alpha2.Landscaper, providerConfig *v1alpha2.ProviderConfig) error {
+	var errs []error
+	for _, name := range ls.Spec.Deployers {
+		offering := providerConfig.Spec.Deployment.GetDeployerOffering(name)
+		switch {
+		case name == v1alpha2.DeployerHelm || name == v1alpha2.DeployerManifest:
+			errs = append(errs, fmt.Errorf("deployer %s is installed by default and must not be selected", name))
+		case offering == nil:
+			errs = append(errs, fmt.Errorf("deployer %s is not offered in provider config %s", name, providerConfig.Name))
+		case !offering.IsBuiltin() && offering.Image == nil:
+			errs = append(errs, fmt.Errorf("custom deployer %s in provider config %s has no image", name, providerConfig.Name))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// deployerConfigs determines the configurations of the selected deployers which are offered by the provider config,
+// and the names of the previously installed deployers which must be uninstalled.
+func deployerConfigs(ls *v1alpha2.Landscaper, providerConfig *v1alpha2.ProviderConfig, resources core.ResourceRequirements,
+	getImagePullSecrets func(*v1alpha2.ImageConfiguration) []v1alpha2.SourceReference) ([]instance.DeployerConfig, []string) {
+
+	deployers := []instance.DeployerConfig{}
+	selected := sets.New[string]()
+	for _, name := range ls.Spec.Deployers {
+		offering := providerConfig.Spec.Deployment.GetDeployerOffering(name)
+		if offering == nil || (!offering.IsBuiltin() && offering.Image == nil) ||
+			name == v1alpha2.DeployerHelm || name == v1alpha2.DeployerManifest {
+			continue
+		}
+
+		d := instance.DeployerConfig{
+			Name: name,
+			Image: v1alpha2.ImageConfiguration{
+				Image:            providerConfig.GetDeployerImageLocation(offering, ls.Spec.Version),
+				ImagePullSecrets: getImagePullSecrets(offering.Image),
+			},
+			Resources:     resources,
+			HPA:           hpaValues(offering.HorizontalScaling),
+			Configuration: offering.Configuration,
+		}
+		if name == v1alpha2.DeployerContainer {
+			d.InitImage = providerConfig.GetContainerDeployerInitImageLocation(offering, ls.Spec.Version)
+			d.WaitImage = providerConfig.GetContainerDeployerWaitImageLocation(offering, ls.Spec.Version)
+		}
+		deployers = append(deployers, d)
+		selected.Insert(name)
+	}
+
+	removed := sets.New(ls.Status.Deployers...).Insert(ls.Spec.Deployers...).Difference(selected)
+	removed.Delete(v1alpha2.DeployerHelm, v1alpha2.DeployerManifest)
+	return deployers, sets.List(removed)
+}
+
+// deployerNames returns the names of the given deployers.
+func deployerNames(deployers []instance.DeployerConfig) []string {
+	names := make([]string, 0, len(deployers))
+	for _, d := range deployers {
+		names = append(names, d.Name)
+	}
+	return names
+}
@@ -0,0 +1,146 @@
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/openmcp-project/controller-utils/pkg/controller"
+	"github.com/openmcp-project/controller-utils/pkg/logging"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/openmcp-project/service-provider-landscaper/api/v1alpha2"
+	"github.com/openmcp-project/service-provider-landscaper/internal/dns"
+)
+
+const (
+	// DefaultDNSMigrationGracePeriod is the default duration for which the previous hostname remains routed
+	// after the webhooks server has been switched to the hostname of a new base domain.
+	DefaultDNSMigrationGracePeriod = 10 * time.Minute
+
+	// dnsMigrationSwitchInterval is the requeue interval after both hostnames are routed,
+	// so that the webhooks server is switched to the new hostname in the next reconciliation.
+	dnsMigrationSwitchInterval = 1 * time.Second
+)
+
+// updateDNSStatus compares the base domain of the selected hostname with the one recorded in the DNS status.
+// If the base domain has changed, a migration is started, and the second return value is true.
+// The hostname with which the webhooks server is currently registered becomes the previous hostname of the migration.
+// A change of the selected scope is migrated in the same way as a change of the base domain.
+func updateDNSStatus(dnsStatus *v1alpha2.DNSStatus, selected dns.HostName, hostNames []dns.HostName, now metav1.Time) (*v1alpha2.DNSStatus, bool) {
+	newStatus := &v1alpha2.DNSStatus{
+		Scope:      v1alpha2.DNSScope(selected.Scope),
+		BaseDomain: selected.BaseDomain,
+		HostName:   selected.HostName,
+		HostNames:  convertHostNames(hostNames),
+	}
+
+	if dnsStatus == nil || dnsStatus.BaseDomain == "" {
+		return newStatus, false
+	}
+
+	if dnsStatus.BaseDomain == selected.BaseDomain {
+		newStatus.Migration = dnsStatus.Migration.DeepCopy()
+		return newStatus, false
+	}
+
+	previousBaseDomain := dnsStatus.BaseDomain
+	previousHostName := dnsStatus.HostName
+	if isDNSMigrationRouting(dnsStatus) {
+		previousBaseDomain = dnsStatus.Migration.PreviousBaseDomain
+		previousHostName = dnsStatus.Migration.PreviousHostName
+	}
+
+	if previousBaseDomain == selected.BaseDomain {
+		// the base domain has been reverted before the webhooks server was switched, so nothing needs to be migrated
+		return newStatus, false
+	}
+
+	newStatus.Migration = &v1alpha2.DNSMigrationStatus{
+		PreviousBaseDomain: previousBaseDomain,
+		PreviousHostName:   previousHostName,
+		Phase:              v1alpha2.DNSMigrationPhaseRouting,
+		LastTransitionTime: now,
+	}
+	return newStatus, true
+}
+
+func convertHostNames(hostNames []dns.HostName) []v1alpha2.DNSHostName {
+	result := make([]v1alpha2.DNSHostName, 0, len(hostNames))
+	for _, hostName := range hostNames {
+		result = append(result, v1alpha2.DNSHostName{
+			Scope:      v1alpha2.DNSScope(hostName.Scope),
+			BaseDomain: hostName.BaseDomain,
+			HostName:   hostName.HostName,
+		})
+	}
+	return result
+}
+
+// webhooksDNSScope returns the scope of the hostname with which the webhooks server is registered in the MCP cluster.
+func webhooksDNSScope(ls *v1alpha2.Landscaper) dns.HostNameScope {
+	if ls.Spec.WebhooksDNSScope == v1alpha2.DNSScopeInternal {
+		return dns.HostNameScopeInternal
+	}
+	return dns.HostNameScopeExternal
+}
+
+// dnsScopeOf returns the scope recorded in the DNS status. A status without scope was written for the external hostname.
+func dnsScopeOf(dnsStatus *v1alpha2.DNSStatus) v1alpha2.DNSScope {
+	if dnsStatus == nil || dnsStatus.Scope == "" {
+		return v1alpha2.DNSScopeExternal
+	}
+	return dnsStatus.Scope
+}
+
+// webhookHostName returns the hostname with which the webhooks server must be registered.
+// As long as the new hostname is not routed, the previous hostname is used.
+func webhookHostName(dnsStatus *v1alpha2.DNSStatus) string {
+	if isDNSMigrationRouting(dnsStatus) {
+		return dnsStatus.Migration.PreviousHostName
+	}
+	return dnsStatus.HostName
+}
+
+// additionalHostNames returns the hostnames that must be routed in addition to the current hostname.
+func additionalHostNames(dnsStatus *v1alpha2.DNSStatus) []string {
+	if dnsStatus.Migration == nil {
+		return nil
+	}
+	return []string{dnsStatus.Migration.PreviousHostName}
+}
+
+func isDNSMigrationRouting(dnsStatus *v1alpha2.DNSStatus) bool {
+	return dnsStatus.Migration != nil && dnsStatus.Migration.Phase == v1alpha2.DNSMigrationPhaseRouting
+}
+
+// triggerDNSMigration sets the reconcile annotation on all other Landscaper resources that are still published
+// under the given base domain, so that they migrate to the new base domain without waiting for their next periodic reconciliation.
+func (r *LandscaperReconciler) triggerDNSMigration(ctx context.Context, ls *v1alpha2.Landscaper, previousBaseDomain string) {
+	log := logging.FromContextOrPanic(ctx)
+
+	landscapers := &v1alpha2.LandscaperList{}
+	if err := r.OnboardingCluster.Client().List(ctx, landscapers); err != nil {
+		log.Error(err, "Failed to list Landscaper resources")
+		return
+	}
+
+	for _, landscaper := range landscapers.Items {
+		if landscaper.Namespace == ls.Namespace && landscaper.Name == ls.Name {
+			continue
+		}
+
+		if landscaper.Status.DNS == nil || landscaper.Status.DNS.BaseDomain != previousBaseDomain {
+			continue
+		}
+
+		log.Debug("Setting reconcile annotation for Landscaper resource to migrate base domain", "landscaper", landscaper.Name, "namespace", landscaper.Namespace)
+
+		if err := controller.EnsureAnnotation(
+			ctx, r.OnboardingCluster.Client(),
+			&landscaper,
+			v1alpha2.LandscaperOperation, v1alpha2.OperationReconcile,
+			true, controller.OVERWRITE); err != nil {
+			log.Error(err, "Failed to set reconcile annotation for Landscaper resource", "landscaper", landscaper.Name, "namespace", landscaper.Namespace)
+		}
+	}
+}
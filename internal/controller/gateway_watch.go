@@ -0,0 +1,339 @@
+package controller
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"maps"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/openmcp-project/controller-utils/pkg/clusters"
+	"github.com/openmcp-project/controller-utils/pkg/controller"
+	"github.com/openmcp-project/controller-utils/pkg/logging"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/apimachinery/pkg/watch"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/openmcp-project/service-provider-landscaper/api/v1alpha2"
+	"github.com/openmcp-project/service-provider-landscaper/internal/dns"
+)
+
+// gatewayWatchRetryInterval is the interval after which a failed or closed watch of a gateway is restarted.
+const gatewayWatchRetryInterval = 10 * time.Second
+
+// GatewayWatcher watches the default gateway on the workload clusters of the Landscaper instances. If the base domains
+// of a gateway change, it sets the reconcile annotation on all Landscaper resources on that workload cluster, so that
+// the DNS migration starts right away instead of with the next periodic reconciliation.
+//
+// The workload cluster of an instance is only known with the credentials of its access request, so that the
+// watches are registered by the reconciliation of the instances. There is one watch per workload cluster, which uses
+// the credentials of one of its instances and is shared by all instances on that cluster.
+type GatewayWatcher struct {
+	OnboardingCluster *clusters.Cluster
+
+	lock sync.Mutex
+	// ctx is the context of the manager, from which the contexts of the watches are derived.
+	// It is nil until the watcher has been started.
+	ctx context.Context
+	// watches are the watches of the workload clusters by their host.
+	watches map[string]*gatewayWatch
+	// hosts are the hosts of the workload clusters of the instances.
+	hosts map[client.ObjectKey]string
+}
+
+type gatewayWatch struct {
+	host string
+	// instances are the workload clusters of the instances, each with the credentials of its instance.
+	instances map[client.ObjectKey]*clusters.Cluster
+	// owner is the instance whose credentials are used for the watch.
+	owner client.ObjectKey
+	// credentials identifies the credentials with which the watch has been started.
+	credentials string
+	// cancel stops the watch. It is nil if the watch is not running.
+	cancel context.CancelFunc
+}
+
+var _ manager.Runnable = &GatewayWatcher{}
+
+// NewGatewayWatcher returns a watcher which triggers the reconciliation of the Landscaper resources on the onboarding cluster.
+func NewGatewayWatcher(onboardingCluster *clusters.Cluster) *GatewayWatcher {
+	return &GatewayWatcher{
+		OnboardingCluster: onboardingCluster,
+		watches:           map[string]*gatewayWatch{},
+		hosts:             map[client.ObjectKey]string{},
+	}
+}
+
+// Start starts the watches registered so far and blocks until the manager stops. The watches are stopped with the
+// context of the manager.
+func (w *GatewayWatcher) Start(ctx context.Context) error {
+	w.lock.Lock()
+	w.ctx = ctx
+	for _, gw := range w.watches {
+		if err := w.start(gw, gw.owner); err != nil {
+			logging.Wrap(ctrl.Log).WithName(controllerName+"/Gateway").Error(err, "failed to start the watch of the default gateway", "host", gw.host)
+		}
+	}
+	w.lock.Unlock()
+
+	<-ctx.Done()
+
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	w.ctx = nil
+	clear(w.watches)
+	clear(w.hosts)
+	return nil
+}
+
+// Watch ensures that the default gateway on the given workload cluster is watched for the Landscaper of the request.
+// A running watch is restarted if the credentials of the instance whose credentials it uses have changed,
+// e.g. by a token rotation.
+func (w *GatewayWatcher) Watch(req reconcile.Request, workloadCluster *clusters.Cluster) error {
+	host := clusterHost(workloadCluster)
+
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	if previous, ok := w.hosts[req.NamespacedName]; ok && previous != host {
+		w.remove(req.NamespacedName)
+	}
+
+	gw, ok := w.watches[host]
+	if !ok {
+		gw = &gatewayWatch{host: host, instances: map[client.ObjectKey]*clusters.Cluster{}, owner: req.NamespacedName}
+		w.watches[host] = gw
+	}
+	gw.instances[req.NamespacedName] = workloadCluster
+	w.hosts[req.NamespacedName] = host
+
+	if gw.cancel != nil && (gw.owner != req.NamespacedName || gw.credentials == clusterCredentials(workloadCluster)) {
+		return nil
+	}
+	return w.start(gw, req.NamespacedName)
+}
+
+// Stop stops watching the default gateway for the Landscaper of the request. The watch of the workload cluster is
+// stopped with its last instance, and restarted with the credentials of another instance if it used the credentials
+// of this instance.
+func (w *GatewayWatcher) Stop(req reconcile.Request) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	w.remove(req.NamespacedName)
+}
+
+// remove removes the instance from the watch of its workload cluster. The caller must hold the lock.
+func (w *GatewayWatcher) remove(key client.ObjectKey) {
+	host, ok := w.hosts[key]
+	if !ok {
+		return
+	}
+	delete(w.hosts, key)
+
+	gw := w.watches[host]
+	delete(gw.instances, key)
+	if len(gw.instances) == 0 {
+		if gw.cancel != nil {
+			gw.cancel()
+		}
+		delete(w.watches, host)
+		return
+	}
+	if gw.owner == key {
+		for other := range gw.instances {
+			if err := w.start(gw, other); err != nil {
+				logging.Wrap(ctrl.Log).WithName(controllerName+"/Gateway").Error(err, "failed to restart the watch of the default gateway", "host", host)
+			}
+			return
+		}
+	}
+}
+
+// start (re)starts the watch with the credentials of the given instance. Until the watcher has been started, only the
+// instance is recorded, and the watch is started with the watcher. The caller must hold the lock.
+func (w *GatewayWatcher) start(gw *gatewayWatch, owner client.ObjectKey) error {
+	if gw.cancel != nil {
+		gw.cancel()
+		gw.cancel = nil
+	}
+	gw.owner = owner
+	if w.ctx == nil {
+		return nil
+	}
+
+	workloadCluster := gw.instances[owner]
+	watchClient, err := watchingClient(workloadCluster)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(w.ctx)
+	gw.credentials = clusterCredentials(workloadCluster)
+	gw.cancel = cancel
+
+	log := logging.Wrap(ctrl.Log).WithName(controllerName+"/Gateway").WithValues("host", gw.host)
+	ctx = logging.NewContext(ctx, log)
+	go w.run(ctx, gw.host, watchClient)
+	return nil
+}
+
+// instances returns the Landscaper instances on the workload cluster with the given host.
+func (w *GatewayWatcher) instances(host string) []client.ObjectKey {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	gw, ok := w.watches[host]
+	if !ok {
+		return nil
+	}
+	return slices.Collect(maps.Keys(gw.instances))
+}
+
+// run watches the default gateway until the context is cancelled. Failed or closed watches are restarted after a list,
+// which also detects changes that happened while no watch was running.
+func (w *GatewayWatcher) run(ctx context.Context, host string, watchClient client.WithWatch) {
+	var baseDomains map[string]string
+
+	wait.UntilWithContext(ctx, func(ctx context.Context) {
+		log := logging.FromContextOrPanic(ctx)
+
+		gateways := &gatewayv1.GatewayList{}
+		if err := watchClient.List(ctx, gateways, client.InNamespace(dns.DefaultGatewayNamespace)); err != nil {
+			log.Error(err, "Failed to list gateways")
+			return
+		}
+		for _, gateway := range gateways.Items {
+			if gateway.Name == dns.DefaultGatewayName {
+				baseDomains = w.handleGateway(ctx, host, baseDomains, &gateway)
+			}
+		}
+
+		watcher, err := watchClient.Watch(ctx, &gatewayv1.GatewayList{}, client.InNamespace(dns.DefaultGatewayNamespace),
+			&client.ListOptions{Raw: &metav1.ListOptions{ResourceVersion: gateways.ResourceVersion}})
+		if err != nil {
+			log.Error(err, "Failed to watch gateways")
+			return
+		}
+		defer watcher.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event, ok := <-watcher.ResultChan():
+				if !ok {
+					return
+				}
+				if event.Type != watch.Added && event.Type != watch.Modified {
+					continue
+				}
+				if gateway, isGateway := event.Object.(*gatewayv1.Gateway); isGateway && gateway.Name == dns.DefaultGatewayName {
+					baseDomains = w.handleGateway(ctx, host, baseDomains, gateway)
+				}
+			}
+		}
+	}, gatewayWatchRetryInterval)
+}
+
+// handleGateway triggers the reconciliation of the Landscapers on the workload cluster if the base domains of the
+// gateway differ from the previously observed ones. It returns the base domains of the gateway.
+func (w *GatewayWatcher) handleGateway(ctx context.Context, host string, previous map[string]string,
+	gateway *gatewayv1.Gateway) map[string]string {
+	log := logging.FromContextOrPanic(ctx)
+
+	current := gatewayBaseDomains(gateway)
+	if previous == nil || maps.Equal(previous, current) {
+		return current
+	}
+
+	log.Info("Base domain of the gateway has changed, triggering reconcile")
+
+	for _, key := range w.instances(host) {
+		ls := &v1alpha2.Landscaper{}
+		if err := w.OnboardingCluster.Client().Get(ctx, key, ls); err != nil {
+			log.Error(err, "Failed to get Landscaper resource", "landscaper", key.Name, "namespace", key.Namespace)
+			continue
+		}
+		if err := controller.EnsureAnnotation(
+			ctx, w.OnboardingCluster.Client(),
+			ls,
+			v1alpha2.LandscaperOperation, v1alpha2.OperationReconcile,
+			true, controller.OVERWRITE); err != nil {
+			log.Error(err, "Failed to set reconcile annotation for Landscaper resource", "landscaper", key.Name, "namespace", key.Namespace)
+		}
+	}
+	return current
+}
+
+// gatewayBaseDomains returns the annotations of the gateway which contain its base domains.
+func gatewayBaseDomains(gateway *gatewayv1.Gateway) map[string]string {
+	baseDomains := map[string]string{}
+	for _, key := range []string{dns.DNSAnnotationKey, dns.InternalDNSAnnotationKey} {
+		baseDomains[key] = gateway.GetAnnotations()[key]
+	}
+	return baseDomains
+}
+
+// watchingClient returns a client for the cluster which supports watches. Clients which already support them,
+// e.g. fake clients in tests, are used directly.
+func watchingClient(cluster *clusters.Cluster) (client.WithWatch, error) {
+	if watchClient, ok := cluster.Client().(client.WithWatch); ok {
+		return watchClient, nil
+	}
+	watchClient, err := client.NewWithWatch(cluster.RESTConfig(), client.Options{Scheme: cluster.Scheme()})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create watching client for cluster %s: %w", cluster.ID(), err)
+	}
+	return watchClient, nil
+}
+
+// clusterHost returns the endpoint of the cluster, which identifies the workload cluster of an instance.
+func clusterHost(cluster *clusters.Cluster) string {
+	config := cluster.RESTConfig()
+	if config == nil {
+		return ""
+	}
+	return config.Host
+}
+
+// clusterCredentials returns a hash of the endpoint and the credentials in the REST config of the cluster.
+func clusterCredentials(cluster *clusters.Cluster) string {
+	config := cluster.RESTConfig()
+	if config == nil {
+		return ""
+	}
+	hash := sha256.New()
+	for _, value := range [][]byte{[]byte(config.Host), []byte(config.BearerToken), []byte(config.BearerTokenFile),
+		config.CertData, config.KeyData, config.CAData} {
+		hash.Write(value)
+		hash.Write([]byte{0})
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// watchGateway ensures that the default gateway on the workload cluster is watched for the Landscaper of the request.
+// A failure is only logged, as changes of the gateway are also detected by the periodic reconciliation.
+func (r *LandscaperReconciler) watchGateway(ctx context.Context, req reconcile.Request, workloadCluster *clusters.Cluster) {
+	if r.GatewayWatcher == nil {
+		return
+	}
+	if err := r.GatewayWatcher.Watch(req, workloadCluster); err != nil {
+		logging.FromContextOrPanic(ctx).Error(err, "failed to watch the default gateway")
+	}
+}
+
+// stopGatewayWatch stops watching the default gateway for the Landscaper of the request.
+func (r *LandscaperReconciler) stopGatewayWatch(req reconcile.Request) {
+	if r.GatewayWatcher != nil {
+		r.GatewayWatcher.Stop(req)
+	}
+}
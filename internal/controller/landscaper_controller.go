@@ -2,6 +2,7 @@ package controller
 
 import (
 	"context"
+	"slices"
 	"time"
 
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
@@ -9,15 +10,17 @@ import (
 	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
 
 	"github.com/openmcp-project/service-provider-landscaper/internal/dns"
+	"github.com/openmcp-project/service-provider-landscaper/internal/shared/sources"
 
-	clustersv1alpha1 "github.com/openmcp-project/openmcp-operator/api/clusters/v1alpha1"
 	"github.com/openmcp-project/openmcp-operator/api/common"
 	"github.com/openmcp-project/openmcp-operator/api/provider/v1alpha1"
 	corev1 "k8s.io/api/core/v1"
-	rbac "k8s.io/api/rbac/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/openmcp-project/service-provider-landscaper/api/install"
@@ -53,6 +56,22 @@ type LandscaperReconciler struct {
 	ProviderName            string
 	ProviderNamespace       string
 
+	// DNSMigrationGracePeriod is the duration for which the previous hostname of an instance remains routed
+	// after its webhooks server has been switched to the hostname of a new base domain.
+	DNSMigrationGracePeriod time.Duration
+
+	// WebhookProbe checks whether the webhooks server of an instance is reachable under its published hostname.
+	// This indirection is needed for injecting a probe against a local server in tests.
+	WebhookProbe dns.WebhookProbe
+
+	// ExternalSecretStore provides the secrets and config maps which the ProviderConfig references with source External.
+	// It is nil if no external secret store is configured.
+	ExternalSecretStore sources.Source
+
+	// GatewayWatcher watches the default gateway on the workload clusters for changes of the base domains.
+	// It is nil in tests which do not need it.
+	GatewayWatcher *GatewayWatcher
+
 	InstanceClusterAccess InstanceClusterAccess
 }
 
@@ -104,8 +123,8 @@ func (r *LandscaperReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		WithMCPScheme(mcpScheme).
 		WithWorkloadScheme(workloadScheme).
 		WithRetryInterval(10 * time.Second).
-		WithMCPPermissions(getMCPPermissions()).
-		WithWorkloadPermissions(getWorkloadPermissions())
+		WithMCPPermissions(MCPPermissions()).
+		WithWorkloadPermissions(WorkloadPermissions())
 
 	r.InstanceClusterAccess = &defaultInstanceClusterAccess{
 		clusterAccessReconciler: r.ClusterAccessReconciler,
@@ -113,8 +132,22 @@ func (r *LandscaperReconciler) SetupWithManager(mgr ctrl.Manager) error {
 
 	r.DNSReconciler = dns.NewReconciler()
 
+	if r.WebhookProbe == nil {
+		r.WebhookProbe = dns.NewTLSWebhookProbe()
+	}
+
+	if r.GatewayWatcher == nil {
+		r.GatewayWatcher = NewGatewayWatcher(r.OnboardingCluster)
+		if err := mgr.Add(r.GatewayWatcher); err != nil {
+			return err
+		}
+	}
+
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&v1alpha2.Landscaper{}).
+		Watches(&corev1.Secret{}, handler.EnqueueRequestsFromMapFunc(r.mapRegistrySecretToRequests(mgr)),
+			builder.OnlyMetadata, builder.WithPredicates(predicate.NewPredicateFuncs(hasWatchLabel)),
+		).
 		WatchesRawSource(source.Kind(r.PlatformCluster.Cluster().GetCache(), &v1alpha2.ProviderConfig{},
 			handler.TypedEnqueueRequestsFromMapFunc(r.mapProviderConfigToRequests(mgr)), controller.ToTypedPredicate[*v1alpha2.ProviderConfig](predicate.GenerationChangedPredicate{}),
 		)).
@@ -174,15 +207,11 @@ func (r *LandscaperReconciler) mapImagePullSecretToRequests(mgr ctrl.Manager) fu
 	return func(ctx context.Context, secret *corev1.Secret) []ctrl.Request {
 		log := logging.Wrap(mgr.GetLogger()).WithName(controllerName + "/Secret")
 
-		if secret.Namespace != r.ProviderNamespace {
+		if !r.isReferencedImagePullSecret(ctx, secret.Namespace, secret.Name) {
 			return nil
 		}
 
-		if !r.isReferencedImagePullSecret(ctx, secret.Name) {
-			return nil
-		}
-
-		log.Debug("Image pull secret changed, triggering reconcile", "secret", secret.Name)
+		log.Debug("Image pull secret changed, triggering reconcile", "secret", secret.Name, "namespace", secret.Namespace)
 
 		landscapers := &v1alpha2.LandscaperList{}
 		if err := r.OnboardingCluster.Client().List(ctx, landscapers); err != nil {
@@ -203,19 +232,63 @@ func (r *LandscaperReconciler) mapImagePullSecretToRequests(mgr ctrl.Manager) fu
 	}
 }
 
-// isReferencedImagePullSecret checks whether the given secret name is referenced as an image pull secret
-// in any ServiceProvider or ProviderConfig resource on the platform cluster.
-func (r *LandscaperReconciler) isReferencedImagePullSecret(ctx context.Context, secretName string) bool {
+// OnboardingCacheOptions returns the cache options of the manager for the onboarding cluster. Only the secrets with
+// the watch label are cached, so that the provider does not cache all secrets of the tenants.
+func OnboardingCacheOptions() cache.Options {
+	return cache.Options{
+		ByObject: map[client.Object]cache.ByObject{
+			&corev1.Secret{}: {
+				Label: labels.SelectorFromSet(labels.Set{v1alpha2.WatchLabel: v1alpha2.WatchLabelValue}),
+			},
+		},
+	}
+}
+
+// hasWatchLabel returns true if the object has the label which marks it for the watches of the provider.
+func hasWatchLabel(obj client.Object) bool {
+	return obj.GetLabels()[v1alpha2.WatchLabel] == v1alpha2.WatchLabelValue
+}
+
+// mapRegistrySecretToRequests returns a handler function that triggers reconciliation of the Landscaper resources
+// in the namespace of a secret on the onboarding cluster, which reference the secret as registry secret.
+// Only the metadata of secrets with the watch label are watched. The secrets themselves are read with the uncached
+// client of the onboarding cluster during the reconciliation.
+func (r *LandscaperReconciler) mapRegistrySecretToRequests(mgr ctrl.Manager) func(context.Context, client.Object) []ctrl.Request {
+	return func(ctx context.Context, secret client.Object) []ctrl.Request {
+		log := logging.Wrap(mgr.GetLogger()).WithName(controllerName + "/RegistrySecret")
+
+		landscapers := &v1alpha2.LandscaperList{}
+		if err := r.OnboardingCluster.Client().List(ctx, landscapers, client.InNamespace(secret.GetNamespace())); err != nil {
+			log.Error(err, "Failed to list Landscaper resources", "namespace", secret.GetNamespace())
+			return nil
+		}
+
+		var requests []ctrl.Request
+		for _, landscaper := range landscapers.Items {
+			if referencesRegistrySecret(&landscaper, secret.GetName()) || r.referencesOnboardingSecret(ctx, &landscaper, secret.GetName()) {
+				log.Debug("Registry secret changed, triggering reconcile", "secret", secret.GetName(), "landscaper", landscaper.Name)
+				requests = append(requests, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(&landscaper)})
+			}
+		}
+		return requests
+	}
+}
+
+// isReferencedImagePullSecret checks whether the given secret of the platform cluster is referenced as an image pull secret
+// or registry secret in any ServiceProvider or ProviderConfig resource on the platform cluster.
+func (r *LandscaperReconciler) isReferencedImagePullSecret(ctx context.Context, namespace, secretName string) bool {
 	log := logging.Wrap(ctrl.Log).WithName(controllerName + "/Secret")
 
-	// Check ServiceProvider image pull secrets
-	serviceProvider := &v1alpha1.ServiceProvider{}
-	if err := r.PlatformCluster.Client().Get(ctx, client.ObjectKey{Name: r.ProviderName}, serviceProvider); err != nil {
-		if !apierrors.IsNotFound(err) {
-			log.Error(err, "Failed to get ServiceProvider", "name", r.ProviderName)
+	if namespace == r.ProviderNamespace {
+		// Check ServiceProvider image pull secrets
+		serviceProvider := &v1alpha1.ServiceProvider{}
+		if err := r.PlatformCluster.Client().Get(ctx, client.ObjectKey{Name: r.ProviderName}, serviceProvider); err != nil {
+			if !apierrors.IsNotFound(err) {
+				log.Error(err, "Failed to get ServiceProvider", "name", r.ProviderName)
+			}
+		} else if referencesSecret(serviceProvider.Spec.ImagePullSecrets, secretName) {
+			return true
 		}
-	} else if referencesSecret(serviceProvider.Spec.ImagePullSecrets, secretName) {
-		return true
 	}
 
 	// Check ProviderConfig image pull secrets
@@ -225,13 +298,12 @@ func (r *LandscaperReconciler) isReferencedImagePullSecret(ctx context.Context,
 		return false
 	}
 	for _, providerConfig := range providerConfigList.Items {
-		for _, imgCfg := range []*v1alpha2.ImageConfiguration{
-			providerConfig.Spec.Deployment.LandscaperController,
-			providerConfig.Spec.Deployment.LandscaperWebhooksServer,
-			providerConfig.Spec.Deployment.HelmDeployer,
-			providerConfig.Spec.Deployment.ManifestDeployer,
-		} {
-			if imgCfg != nil && referencesSecret(imgCfg.ImagePullSecrets, secretName) {
+		if r.referencesSource(providerConfig.Spec.RegistrySecrets, namespace, secretName) ||
+			r.referencesSource(providerRegistrySecrets(&providerConfig), namespace, secretName) {
+			return true
+		}
+		for _, imgCfg := range imageConfigurations(&providerConfig) {
+			if r.referencesSource(imgCfg.ImagePullSecrets, namespace, secretName) {
 				return true
 			}
 		}
@@ -240,6 +312,43 @@ func (r *LandscaperReconciler) isReferencedImagePullSecret(ctx context.Context,
 	return false
 }
 
+// referencesOnboardingSecret checks whether the ProviderConfig of the Landscaper references the given secret
+// of the namespace of the Landscaper on the onboarding cluster as image pull secret or registry secret.
+func (r *LandscaperReconciler) referencesOnboardingSecret(ctx context.Context, ls *v1alpha2.Landscaper, secretName string) bool {
+	if ls.Status.ProviderConfigRef == nil {
+		return false
+	}
+
+	providerConfig := &v1alpha2.ProviderConfig{}
+	if err := r.PlatformCluster.Client().Get(ctx, client.ObjectKey{Name: ls.Status.ProviderConfigRef.Name}, providerConfig); err != nil {
+		return false
+	}
+
+	refs := slices.Concat(providerConfig.Spec.RegistrySecrets, providerRegistrySecrets(providerConfig))
+	for _, imgCfg := range imageConfigurations(providerConfig) {
+		refs = append(refs, imgCfg.ImagePullSecrets...)
+	}
+	return slices.ContainsFunc(refs, func(ref v1alpha2.SourceReference) bool {
+		return ref.GetSource() == v1alpha2.SourceOnboarding && ref.Name == secretName
+	})
+}
+
+// imageConfigurations returns the image configurations of all components in the ProviderConfig.
+func imageConfigurations(providerConfig *v1alpha2.ProviderConfig) []*v1alpha2.ImageConfiguration {
+	imgCfgs := []*v1alpha2.ImageConfiguration{
+		providerConfig.Spec.Deployment.LandscaperController,
+		providerConfig.Spec.Deployment.LandscaperWebhooksServer,
+		providerConfig.Spec.Deployment.HelmDeployer,
+		providerConfig.Spec.Deployment.ManifestDeployer,
+	}
+	for _, offering := range providerConfig.Spec.Deployment.Deployers {
+		imgCfgs = append(imgCfgs, offering.Image)
+	}
+	return slices.DeleteFunc(imgCfgs, func(imgCfg *v1alpha2.ImageConfiguration) bool {
+		return imgCfg == nil
+	})
+}
+
 // referencesSecret checks whether the given secret name appears in the provided list of object references.
 func referencesSecret(refs []common.LocalObjectReference, name string) bool {
 	for _, ref := range refs {
@@ -250,17 +359,27 @@ func referencesSecret(refs []common.LocalObjectReference, name string) bool {
 	return false
 }
 
+// referencesSource checks whether one of the references points to the secret or config map with the given name
+// in the given namespace of the platform cluster. References without namespace point to the namespace of the provider.
+func (r *LandscaperReconciler) referencesSource(refs []v1alpha2.SourceReference, namespace, name string) bool {
+	for _, ref := range refs {
+		if ref.GetSource() != v1alpha2.SourcePlatform || ref.Name != name {
+			continue
+		}
+		if ref.Namespace == namespace || (ref.Namespace == "" && namespace == r.ProviderNamespace) {
+			return true
+		}
+	}
+	return false
+}
+
 // mapCABundleConfigMapToRequests returns a handler function that triggers reconciliation of Landscaper resources
 // whenever a ProviderConfig-referenced CA bundle ConfigMap changes.
 func (r *LandscaperReconciler) mapCABundleConfigMapToRequests(mgr ctrl.Manager) func(context.Context, *corev1.ConfigMap) []ctrl.Request {
 	return func(ctx context.Context, configMap *corev1.ConfigMap) []ctrl.Request {
 		log := logging.Wrap(mgr.GetLogger()).WithName(controllerName + "/ConfigMap")
 
-		if configMap.Namespace != r.ProviderNamespace {
-			return nil
-		}
-
-		if !r.isReferencedCaConfigMap(ctx, configMap.Name) {
+		if !r.isReferencedCaConfigMap(ctx, configMap.Namespace, configMap.Name) {
 			return nil
 		}
 
@@ -285,9 +404,9 @@ func (r *LandscaperReconciler) mapCABundleConfigMapToRequests(mgr ctrl.Manager)
 	}
 }
 
-// isReferencedCaConfigMap checks whether the given configmap name is referenced as a CA bundle
+// isReferencedCaConfigMap checks whether the given configmap of the platform cluster is referenced as a CA bundle
 // in any ProviderConfig resource on the platform cluster.
-func (r *LandscaperReconciler) isReferencedCaConfigMap(ctx context.Context, configMapName string) bool {
+func (r *LandscaperReconciler) isReferencedCaConfigMap(ctx context.Context, namespace, configMapName string) bool {
 	log := logging.Wrap(ctrl.Log).WithName(controllerName + "/ConfigMap")
 
 	providerConfigList := &v1alpha2.ProviderConfigList{}
@@ -297,80 +416,10 @@ func (r *LandscaperReconciler) isReferencedCaConfigMap(ctx context.Context, conf
 	}
 
 	for _, providerConfig := range providerConfigList.Items {
-		if providerConfig.Spec.CABundleRef != nil && providerConfig.Spec.CABundleRef.Name == configMapName {
+		if caBundleRef := providerConfig.Spec.CABundleRef; caBundleRef != nil &&
+			r.referencesSource([]v1alpha2.SourceReference{caBundleRef.SourceReference}, namespace, configMapName) {
 			return true
 		}
 	}
 	return false
 }
-
-func getMCPPermissions() []clustersv1alpha1.PermissionsRequest {
-	defaultVerbs := []string{"get", "list", "watch", "create", "update", "patch", "delete"}
-
-	return []clustersv1alpha1.PermissionsRequest{
-		{
-			Rules: []rbac.PolicyRule{
-				{
-					APIGroups: []string{"apiextensions.k8s.io"},
-					Resources: []string{"customresourcedefinitions"},
-					Verbs:     defaultVerbs,
-				},
-				{
-					APIGroups: []string{"landscaper.gardener.cloud"},
-					Resources: []string{"*"},
-					Verbs:     defaultVerbs,
-				},
-				{
-					APIGroups: []string{""},
-					Resources: []string{"secrets", "configmaps"},
-					Verbs:     defaultVerbs,
-				},
-				{
-					APIGroups: []string{""},
-					Resources: []string{"serviceaccounts"},
-					Verbs:     defaultVerbs,
-				},
-				{
-					APIGroups: []string{""},
-					Resources: []string{"serviceaccounts/token"},
-					Verbs:     []string{"create"},
-				},
-				{
-					APIGroups: []string{""},
-					Resources: []string{"namespaces"},
-					Verbs:     defaultVerbs,
-				},
-				{
-					APIGroups: []string{"rbac.authorization.k8s.io"},
-					Resources: []string{"clusterroles", "clusterrolebindings"},
-					Verbs:     defaultVerbs,
-				},
-				{
-					APIGroups: []string{""},
-					Resources: []string{"events"},
-					Verbs:     defaultVerbs,
-				},
-				{
-					APIGroups: []string{"admissionregistration.k8s.io"},
-					Resources: []string{"validatingwebhookconfigurations"},
-					Verbs:     defaultVerbs,
-				},
-			},
-		},
-	}
-}
-
-func getWorkloadPermissions() []clustersv1alpha1.PermissionsRequest {
-	return []clustersv1alpha1.PermissionsRequest{
-		{
-			// TODO: refine the permissions for the Workload cluster
-			Rules: []rbac.PolicyRule{
-				{
-					APIGroups: []string{"*"},
-					Resources: []string{"*"},
-					Verbs:     []string{"*"},
-				},
-			},
-		},
-	}
-}
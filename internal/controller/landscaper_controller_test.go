@@ -2,15 +2,26 @@ package controller_test
 
 import (
 	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strconv"
 	"time"
 
+	lsconfig "github.com/openmcp-project/landscaper/apis/config/v1alpha1"
+	lscore "github.com/openmcp-project/landscaper/apis/core/v1alpha1"
+	helmv1alpha1 "github.com/openmcp-project/landscaper/apis/deployer/helm/v1alpha1"
+	manifestv1alpha2 "github.com/openmcp-project/landscaper/apis/deployer/manifest/v1alpha2"
 	libutils "github.com/openmcp-project/openmcp-operator/lib/utils"
+	admissionv1 "k8s.io/api/admissionregistration/v1"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/utils/ptr"
 
 	"github.com/openmcp-project/service-provider-landscaper/internal/dns"
 	configmapsync "github.com/openmcp-project/service-provider-landscaper/internal/shared/configmaps"
+	"github.com/openmcp-project/service-provider-landscaper/internal/shared/sources"
+	"github.com/openmcp-project/service-provider-landscaper/internal/shared/verticalscaling"
 
 	"github.com/openmcp-project/service-provider-landscaper/internal/shared/identity"
 
@@ -19,7 +30,9 @@ import (
 	deploymentv1alpha1 "github.com/openmcp-project/openmcp-operator/api/provider/v1alpha1"
 	"github.com/openmcp-project/openmcp-operator/lib/clusteraccess"
 	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/selection"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 
@@ -28,8 +41,11 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/yaml"
 
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/openmcp-project/service-provider-landscaper/api/v1alpha2"
@@ -128,6 +144,154 @@ func expectCaConfigMapValid(ctx context.Context, c client.Client, deployment *ap
 
 }
 
+// clusterAccessRequests returns the requests that are created by the cluster access reconciler for the given Landscaper.
+func clusterAccessRequests(req reconcile.Request) (*clustersv1alpha1.AccessRequest, *clustersv1alpha1.ClusterRequest, *clustersv1alpha1.AccessRequest) {
+	requestNamespace, err := libutils.StableMCPNamespace(req.Name, req.Namespace)
+	Expect(err).NotTo(HaveOccurred())
+	requestNameMCP := clusteraccess.StableRequestName(controllerName, req) + "--mcp"
+	requestNameWorkload := clusteraccess.StableRequestName(controllerName, req) + "--wl"
+
+	accessRequestMCP := &clustersv1alpha1.AccessRequest{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      requestNameMCP,
+			Namespace: requestNamespace,
+		},
+	}
+
+	workloadClusterRequest := &clustersv1alpha1.ClusterRequest{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      requestNameWorkload,
+			Namespace: requestNamespace,
+		},
+	}
+
+	workloadAccessRequest := &clustersv1alpha1.AccessRequest{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      requestNameWorkload,
+			Namespace: requestNamespace,
+		},
+	}
+
+	return accessRequestMCP, workloadClusterRequest, workloadAccessRequest
+}
+
+// grantClusterAccess reconciles the Landscaper until the cluster access requests have been created and grants them.
+// The cluster access reconciler processes its registrations in no particular order, so each request is granted as soon as it exists.
+func grantClusterAccess(env *testutils.Environment, req reconcile.Request, accessRequestMCP *clustersv1alpha1.AccessRequest,
+	workloadClusterRequest *clustersv1alpha1.ClusterRequest, workloadAccessRequest *clustersv1alpha1.AccessRequest) {
+	granted := func(phase string) bool {
+		return phase == clustersv1alpha1.REQUEST_GRANTED
+	}
+
+	for i := 0; i < 5; i++ {
+		if granted(accessRequestMCP.Status.Phase) && granted(workloadClusterRequest.Status.Phase) && granted(workloadAccessRequest.Status.Phase) {
+			return
+		}
+
+		reconcileResult := env.ShouldReconcile(req, "reconcile should return a requeue time")
+		Expect(reconcileResult.RequeueAfter).ToNot(BeZero())
+
+		if !granted(accessRequestMCP.Status.Phase) && env.Client().Get(env.Ctx, client.ObjectKeyFromObject(accessRequestMCP), accessRequestMCP) == nil {
+			accessRequestMCP.Status.Phase = clustersv1alpha1.REQUEST_GRANTED
+			accessRequestMCP.Status.SecretRef = &commonapi.LocalObjectReference{
+				Name: "access",
+			}
+			Expect(env.Client().Status().Update(env.Ctx, accessRequestMCP)).To(Succeed())
+		}
+
+		if !granted(workloadClusterRequest.Status.Phase) && env.Client().Get(env.Ctx, client.ObjectKeyFromObject(workloadClusterRequest), workloadClusterRequest) == nil {
+			workloadClusterRequest.Status.Phase = clustersv1alpha1.REQUEST_GRANTED
+			Expect(env.Client().Status().Update(env.Ctx, workloadClusterRequest)).To(Succeed())
+		}
+
+		if !granted(workloadAccessRequest.Status.Phase) && env.Client().Get(env.Ctx, client.ObjectKeyFromObject(workloadAccessRequest), workloadAccessRequest) == nil {
+			workloadAccessRequest.Status.Phase = clustersv1alpha1.REQUEST_GRANTED
+			workloadAccessRequest.Status.SecretRef = &commonapi.LocalObjectReference{
+				Name: "access",
+			}
+			Expect(env.Client().Status().Update(env.Ctx, workloadAccessRequest)).To(Succeed())
+		}
+	}
+
+	Expect(granted(accessRequestMCP.Status.Phase)).To(BeTrue(), "mcp access request should have been granted")
+	Expect(granted(workloadClusterRequest.Status.Phase)).To(BeTrue(), "workload cluster request should have been granted")
+	Expect(granted(workloadAccessRequest.Status.Phase)).To(BeTrue(), "workload access request should have been granted")
+}
+
+// setTLSRouteAccepted marks the TLSRoute as accepted by the default gateway, with resolved backend references.
+func setTLSRouteAccepted(ctx context.Context, tlsRoute *gatewayv1alpha2.TLSRoute, c client.Client) {
+	Expect(c.Get(ctx, client.ObjectKeyFromObject(tlsRoute), tlsRoute)).To(Succeed())
+	tlsRoute.Status.Parents = []gatewayv1alpha2.RouteParentStatus{
+		{
+			ParentRef: gatewayv1alpha2.ParentReference{
+				Name:      dns.DefaultGatewayName,
+				Namespace: ptr.To(gatewayv1.Namespace(dns.DefaultGatewayNamespace)),
+			},
+			Conditions: []metav1.Condition{
+				{
+					Type:   string(gatewayv1alpha2.RouteConditionAccepted),
+					Status: metav1.ConditionTrue,
+				},
+				{
+					Type:   string(gatewayv1alpha2.RouteConditionResolvedRefs),
+					Status: metav1.ConditionTrue,
+				},
+			},
+		},
+	}
+	Expect(c.Status().Update(ctx, tlsRoute)).To(Succeed())
+}
+
+const testCABundle = "test-ca-bundle"
+
+// registerWebhooks creates or updates the validating webhook configuration in the mcp cluster,
+// as it is done by the webhooks server when it starts with the given hostname.
+func registerWebhooks(ctx context.Context, c client.Client, hostName string) {
+	webhookConfig := &admissionv1.ValidatingWebhookConfiguration{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "landscaper-validation-webhook",
+		},
+	}
+
+	_, err := controllerutil.CreateOrUpdate(ctx, c, webhookConfig, func() error {
+		webhookConfig.Webhooks = nil
+		for _, resource := range []string{"installations", "executions", "deployitems"} {
+			webhookConfig.Webhooks = append(webhookConfig.Webhooks, admissionv1.ValidatingWebhook{
+				Name: resource + ".validation.landscaper.gardener.cloud",
+				ClientConfig: admissionv1.WebhookClientConfig{
+					URL:      ptr.To("https://" + hostName + ":9443/webhook/validate/" + resource),
+					CABundle: []byte(testCABundle),
+				},
+				SideEffects:             ptr.To(admissionv1.SideEffectClassNone),
+				AdmissionReviewVersions: []string{"v1"},
+			})
+		}
+		return nil
+	})
+	Expect(err).ToNot(HaveOccurred())
+}
+
+// testWebhookProbe records the arguments of the last probe and returns the configured error.
+type testWebhookProbe struct {
+	err      error
+	address  string
+	hostName string
+	caBundle []byte
+}
+
+func (p *testWebhookProbe) Probe(_ context.Context, address, hostName string, caBundle []byte) error {
+	p.address = address
+	p.hostName = hostName
+	p.caBundle = caBundle
+	return p.err
+}
+
+func webhookProbe(env *testutils.Environment) *testWebhookProbe {
+	r, err := testutils.ReconcilerAs[*lscontroller.LandscaperReconciler](env)
+	Expect(err).NotTo(HaveOccurred())
+	return r.WebhookProbe.(*testWebhookProbe)
+}
+
 type testInstanceClusterAccess struct {
 	mcpCluster      *clusters.Cluster
 	workloadCluster *clusters.Cluster
@@ -142,6 +306,20 @@ func (t *testInstanceClusterAccess) WorkloadCluster(ctx context.Context, req rec
 }
 
 func buildTestEnvironmentReconcile(testdataDir string, objectsWithStatus ...client.Object) *testutils.Environment {
+	return buildTestEnvironmentReconcileWithClients(testdataDir, nil, nil, objectsWithStatus...)
+}
+
+// buildTestEnvironmentReconcileWithWorkloadClient builds the test environment. If workloadClient is set,
+// it wraps the client with which the reconciler accesses the workload cluster.
+func buildTestEnvironmentReconcileWithWorkloadClient(testdataDir string, workloadClient func(client.WithWatch) client.WithWatch,
+	objectsWithStatus ...client.Object) *testutils.Environment {
+	return buildTestEnvironmentReconcileWithClients(testdataDir, workloadClient, nil, objectsWithStatus...)
+}
+
+// buildTestEnvironmentReconcileWithClients builds the test environment. If workloadClient or onboardingClient are set,
+// they wrap the client with which the reconciler accesses the workload or the onboarding cluster.
+func buildTestEnvironmentReconcileWithClients(testdataDir string, workloadClient, onboardingClient func(client.WithWatch) client.WithWatch,
+	objectsWithStatus ...client.Object) *testutils.Environment {
 	scheme := runtime.NewScheme()
 	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
 	utilruntime.Must(clustersv1alpha1.AddToScheme(scheme))
@@ -149,6 +327,8 @@ func buildTestEnvironmentReconcile(testdataDir string, objectsWithStatus ...clie
 	utilruntime.Must(v1alpha2.AddToScheme(scheme))
 	utilruntime.Must(gatewayv1.Install(scheme))
 	utilruntime.Must(gatewayv1alpha2.Install(scheme))
+	scheme.AddKnownTypeWithName(verticalscaling.GroupVersionKind, &unstructured.Unstructured{})
+	scheme.AddKnownTypeWithName(verticalscaling.GroupVersionKind.GroupVersion().WithKind("VerticalPodAutoscalerList"), &unstructured.UnstructuredList{})
 
 	return testutils.NewEnvironmentBuilder().
 		WithFakeClient(scheme).
@@ -176,6 +356,13 @@ func buildTestEnvironmentReconcile(testdataDir string, objectsWithStatus ...clie
 
 			platformCluster := clusters.NewTestClusterFromClient("platform", c)
 			onboardingCluster := clusters.NewTestClusterFromClient("onboarding", c)
+			if onboardingClient != nil {
+				onboardingCluster = clusters.NewTestClusterFromClient("onboarding", onboardingClient(c.(client.WithWatch)))
+			}
+			workloadCluster := clusters.NewTestClusterFromClient("workload", c)
+			if workloadClient != nil {
+				workloadCluster = clusters.NewTestClusterFromClient("workload", workloadClient(c.(client.WithWatch)))
+			}
 
 			r := &lscontroller.LandscaperReconciler{
 				Scheme:                  scheme,
@@ -184,10 +371,11 @@ func buildTestEnvironmentReconcile(testdataDir string, objectsWithStatus ...clie
 				OnboardingCluster:       onboardingCluster,
 				InstanceClusterAccess: &testInstanceClusterAccess{
 					mcpCluster:      clusters.NewTestClusterFromClient("mcp", c),
-					workloadCluster: clusters.NewTestClusterFromClient("workload", c),
+					workloadCluster: workloadCluster,
 				},
 				ProviderName:      "landscaper",
 				ProviderNamespace: "openmcp-system",
+				WebhookProbe:      &testWebhookProbe{},
 			}
 
 			return r
@@ -259,31 +447,7 @@ var _ = Describe("Landscaper Controller", func() {
 				},
 			}
 
-			requestNamespace, err := libutils.StableMCPNamespace(req.Name, req.Namespace)
-			Expect(err).NotTo(HaveOccurred())
-			requestNameMCP := clusteraccess.StableRequestName(controllerName, req) + "--mcp"
-			requestNameWorkload := clusteraccess.StableRequestName(controllerName, req) + "--wl"
-
-			accessRequestMCP := &clustersv1alpha1.AccessRequest{
-				ObjectMeta: metav1.ObjectMeta{
-					Name:      requestNameMCP,
-					Namespace: requestNamespace,
-				},
-			}
-
-			workloadClusterRequest := &clustersv1alpha1.ClusterRequest{
-				ObjectMeta: metav1.ObjectMeta{
-					Name:      requestNameWorkload,
-					Namespace: requestNamespace,
-				},
-			}
-
-			workloadAccessRequest := &clustersv1alpha1.AccessRequest{
-				ObjectMeta: metav1.ObjectMeta{
-					Name:      requestNameWorkload,
-					Namespace: requestNamespace,
-				},
-			}
+			accessRequestMCP, workloadClusterRequest, workloadAccessRequest := clusterAccessRequests(req)
 
 			ls := &v1alpha2.Landscaper{
 				ObjectMeta: metav1.ObjectMeta{
@@ -351,67 +515,19 @@ var _ = Describe("Landscaper Controller", func() {
 				helmDeployerDeployment,
 				tlsRoute)
 
-			reconcileResult := env.ShouldReconcile(req, "reconcile should return a requeue time")
-			Expect(reconcileResult.RequeueAfter).ToNot(BeZero())
-
-			// now waiting for the MCP access request to be granted
-			reconcileResult = env.ShouldReconcile(req, "reconcile should return a requeue time")
-			Expect(reconcileResult.RequeueAfter).ToNot(BeZero())
-
-			Expect(env.Client().Get(env.Ctx, client.ObjectKeyFromObject(accessRequestMCP), accessRequestMCP)).To(Succeed())
-
-			accessRequestMCP.Status.Phase = clustersv1alpha1.REQUEST_GRANTED
-			accessRequestMCP.Status.SecretRef = &commonapi.LocalObjectReference{
-				Name: "access",
-			}
-
-			Expect(env.Client().Status().Update(env.Ctx, accessRequestMCP)).To(Succeed())
-
-			// now wait for the workload cluster request to be granted
-			reconcileResult = env.ShouldReconcile(req, "reconcile should return a requeue time")
-			Expect(reconcileResult.RequeueAfter).ToNot(BeZero())
-
-			Expect(env.Client().Get(env.Ctx, client.ObjectKeyFromObject(workloadClusterRequest), workloadClusterRequest)).To(Succeed())
-
-			workloadClusterRequest.Status.Phase = clustersv1alpha1.REQUEST_GRANTED
-
-			Expect(env.Client().Status().Update(env.Ctx, workloadClusterRequest)).To(Succeed())
-			Expect(reconcileResult.RequeueAfter).ToNot(BeZero())
-
-			// now wait for the workload access request to be granted
-			reconcileResult = env.ShouldReconcile(req, "reconcile should return a requeue time")
-			Expect(reconcileResult.RequeueAfter).ToNot(BeZero())
-
-			Expect(env.Client().Get(env.Ctx, client.ObjectKeyFromObject(workloadAccessRequest), workloadAccessRequest)).To(Succeed())
-
-			workloadAccessRequest.Status.Phase = clustersv1alpha1.REQUEST_GRANTED
-			workloadAccessRequest.Status.SecretRef = &commonapi.LocalObjectReference{
-				Name: "access",
-			}
-
-			Expect(env.Client().Status().Update(env.Ctx, workloadAccessRequest)).To(Succeed())
+			grantClusterAccess(env, req, accessRequestMCP, workloadClusterRequest, workloadAccessRequest)
 
 			// now the landscaper should wait for the tls route to be created and ready
-			reconcileResult = env.ShouldReconcile(req, "reconcile should not return a requeue time")
+			reconcileResult := env.ShouldReconcile(req, "reconcile should not return a requeue time")
 			Expect(reconcileResult.RequeueAfter).ToNot(BeZero())
 
+			Expect(env.Client().Get(env.Ctx, client.ObjectKeyFromObject(ls), ls)).To(Succeed())
+			Expect(ls.Status.Conditions[0].Type).To(Equal(v1alpha2.ConditionTypeInstalled))
+			Expect(ls.Status.Conditions[0].Status).To(Equal(metav1.ConditionFalse))
+			Expect(ls.Status.Conditions[0].Reason).To(Equal(v1alpha2.ConditionReasonWaitForDNSReady))
+
 			// set the tls route to ready
-			Expect(env.Client().Get(env.Ctx, client.ObjectKeyFromObject(tlsRoute), tlsRoute)).To(Succeed())
-			tlsRoute.Status.Parents = []gatewayv1alpha2.RouteParentStatus{
-				{
-					ParentRef: gatewayv1alpha2.ParentReference{
-						Name:      dns.DefaultGatewayName,
-						Namespace: ptr.To(gatewayv1.Namespace(dns.DefaultGatewayNamespace)),
-					},
-					Conditions: []metav1.Condition{
-						{
-							Type:   string(gatewayv1alpha2.RouteConditionAccepted),
-							Status: metav1.ConditionTrue,
-						},
-					},
-				},
-			}
-			Expect(env.Client().Status().Update(env.Ctx, tlsRoute)).To(Succeed())
+			setTLSRouteAccepted(env.Ctx, tlsRoute, env.Client())
 
 			// now the landscaper should be installed and wait for readiness check
 			reconcileResult = env.ShouldReconcile(req, "reconcile should not return a requeue time")
@@ -459,15 +575,60 @@ var _ = Describe("Landscaper Controller", func() {
 			setDeploymentReady(env.Ctx, manifestDeployerDeployment, env.Client())
 			setDeploymentReady(env.Ctx, helmDeployerDeployment, env.Client())
 
-			// now the landscaper should be ready
-			reconcileResult = env.ShouldReconcile(req, "reconcile should not return a requeue time")
+			// the landscaper should not be ready as long as the webhooks server is not registered in the mcp cluster
+			reconcileResult = env.ShouldReconcile(req, "reconcile should return a requeue time")
+			Expect(reconcileResult.RequeueAfter).ToNot(BeZero())
 
 			Expect(env.Client().Get(env.Ctx, client.ObjectKeyFromObject(ls), ls)).To(Succeed())
-			Expect(ls.Status.Conditions).To(HaveLen(2))
+			Expect(ls.Status.Conditions).To(HaveLen(3))
+			Expect(ls.Status.Conditions[1].Type).To(Equal(v1alpha2.ConditionTypeReady))
+			Expect(ls.Status.Conditions[1].Status).To(Equal(metav1.ConditionFalse))
+			Expect(ls.Status.Conditions[2].Type).To(Equal(v1alpha2.ConditionTypeWebhookReachable))
+			Expect(ls.Status.Conditions[2].Status).To(Equal(metav1.ConditionFalse))
+			Expect(ls.Status.Conditions[2].Message).To(ContainSubstring("not found"))
+
+			// the landscaper should not be ready as long as the webhooks server is not reachable
+			registerWebhooks(env.Ctx, env.Client(), ls.Status.DNS.HostName)
+			probe := webhookProbe(env)
+			probe.err = errors.New("connection refused")
+
+			reconcileResult = env.ShouldReconcile(req, "reconcile should return a requeue time")
+			Expect(reconcileResult.RequeueAfter).ToNot(BeZero())
+
+			Expect(env.Client().Get(env.Ctx, client.ObjectKeyFromObject(ls), ls)).To(Succeed())
+			Expect(ls.Status.Conditions[2].Type).To(Equal(v1alpha2.ConditionTypeWebhookReachable))
+			Expect(ls.Status.Conditions[2].Status).To(Equal(metav1.ConditionFalse))
+			Expect(ls.Status.Conditions[2].Message).To(ContainSubstring("connection refused"))
+			Expect(probe.address).To(Equal(ls.Status.DNS.HostName + ":9443"))
+			Expect(probe.hostName).To(Equal(ls.Status.DNS.HostName))
+			Expect(probe.caBundle).To(Equal([]byte(testCABundle)))
+
+			// the token of the workload access request is due for renewal in two minutes
+			now := time.Now()
+			Expect(env.Client().Create(env.Ctx, &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      workloadAccessRequest.Status.SecretRef.Name,
+					Namespace: workloadAccessRequest.Namespace,
+				},
+				Data: map[string][]byte{
+					clustersv1alpha1.SecretKeyCreationTimestamp:   []byte(strconv.FormatInt(now.Add(-30*time.Minute).Unix(), 10)),
+					clustersv1alpha1.SecretKeyExpirationTimestamp: []byte(strconv.FormatInt(now.Add(10*time.Minute).Unix(), 10)),
+				},
+			})).To(Succeed())
+
+			// now the landscaper should be ready, and be reconciled again ahead of the token renewal
+			probe.err = nil
+			reconcileResult = env.ShouldReconcile(req, "reconcile should return a requeue time")
+			Expect(reconcileResult.RequeueAfter).To(BeNumerically("~", 2*time.Minute, 5*time.Second))
+
+			Expect(env.Client().Get(env.Ctx, client.ObjectKeyFromObject(ls), ls)).To(Succeed())
+			Expect(ls.Status.Conditions).To(HaveLen(3))
 			Expect(ls.Status.Conditions[0].Type).To(Equal(v1alpha2.ConditionTypeInstalled))
 			Expect(ls.Status.Conditions[0].Status).To(Equal(metav1.ConditionTrue))
 			Expect(ls.Status.Conditions[1].Type).To(Equal(v1alpha2.ConditionTypeReady))
 			Expect(ls.Status.Conditions[1].Status).To(Equal(metav1.ConditionTrue))
+			Expect(ls.Status.Conditions[2].Type).To(Equal(v1alpha2.ConditionTypeWebhookReachable))
+			Expect(ls.Status.Conditions[2].Status).To(Equal(metav1.ConditionTrue))
 			Expect(ls.Status.Phase).To(Equal(v1alpha2.PhaseReady))
 
 			// delete the landscaper instance
@@ -487,5 +648,1092 @@ var _ = Describe("Landscaper Controller", func() {
 				g.Expect(env.Client().Get(env.Ctx, client.ObjectKeyFromObject(workloadClusterRequest), workloadClusterRequest)).ToNot(Succeed())
 			}, 10*time.Second, 1*time.Second).Should(Succeed())
 		})
+
+		It("should migrate the webhooks server to a new base domain of the gateway", func() {
+			req := reconcile.Request{
+				NamespacedName: client.ObjectKey{
+					Name:      "test",
+					Namespace: "default",
+				},
+			}
+
+			accessRequestMCP, workloadClusterRequest, workloadAccessRequest := clusterAccessRequests(req)
+
+			ls := &v1alpha2.Landscaper{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      req.Name,
+					Namespace: req.Namespace,
+				},
+			}
+
+			identity.SetInstanceID(ls, identity.ComputeInstanceID(ls))
+			installationNamespace := identity.Instance(identity.GetInstanceID(ls)).Namespace()
+
+			deployments := []*appsv1.Deployment{}
+			for _, name := range []string{"landscaper-controller", "landscaper-controller-main", "landscaper-webhooks-server", "manifest-deployer", "helm-deployer"} {
+				deployments = append(deployments, &appsv1.Deployment{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      name,
+						Namespace: installationNamespace,
+					},
+				})
+			}
+			lsWebhooksServerDeployment := deployments[2]
+
+			tlsRoute := &gatewayv1alpha2.TLSRoute{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "webhooks-tls",
+					Namespace: installationNamespace,
+				},
+			}
+
+			objectsWithStatus := []client.Object{accessRequestMCP, workloadClusterRequest, workloadAccessRequest, tlsRoute}
+			for _, deployment := range deployments {
+				objectsWithStatus = append(objectsWithStatus, deployment)
+			}
+
+			env := buildTestEnvironmentReconcile("test-04", objectsWithStatus...)
+			reconciler, err := testutils.ReconcilerAs[*lscontroller.LandscaperReconciler](env)
+			Expect(err).NotTo(HaveOccurred())
+
+			setDeploymentsReady := func() {
+				for _, deployment := range deployments {
+					Expect(env.Client().Get(env.Ctx, client.ObjectKeyFromObject(deployment), deployment)).To(Succeed())
+					setDeploymentReady(env.Ctx, deployment, env.Client())
+				}
+			}
+
+			grantClusterAccess(env, req, accessRequestMCP, workloadClusterRequest, workloadAccessRequest)
+
+			env.ShouldReconcile(req, "reconcile should create the tls route")
+			setTLSRouteAccepted(env.Ctx, tlsRoute, env.Client())
+			env.ShouldReconcile(req, "reconcile should install the landscaper instance")
+			setDeploymentsReady()
+			Expect(env.Client().Get(env.Ctx, client.ObjectKeyFromObject(ls), ls)).To(Succeed())
+			registerWebhooks(env.Ctx, env.Client(), ls.Status.DNS.HostName)
+			env.ShouldReconcile(req, "reconcile should set the landscaper instance to ready")
+
+			Expect(env.Client().Get(env.Ctx, client.ObjectKeyFromObject(ls), ls)).To(Succeed())
+			Expect(ls.Status.Phase).To(Equal(v1alpha2.PhaseReady))
+			Expect(ls.Status.DNS).ToNot(BeNil())
+			Expect(ls.Status.DNS.BaseDomain).To(Equal("openmcp.cluster.local"))
+			Expect(ls.Status.DNS.HostName).To(HaveSuffix(".openmcp.cluster.local"))
+			Expect(ls.Status.DNS.Migration).To(BeNil())
+			oldHostName := ls.Status.DNS.HostName
+
+			Expect(env.Client().Get(env.Ctx, client.ObjectKeyFromObject(lsWebhooksServerDeployment), lsWebhooksServerDeployment)).To(Succeed())
+			Expect(lsWebhooksServerDeployment.Spec.Template.Spec.Containers[0].Args).To(ContainElement("--webhook-url=https://" + oldHostName + ":9443"))
+
+			// change the base domain of the gateway
+			gateway := &gatewayv1.Gateway{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      dns.DefaultGatewayName,
+					Namespace: dns.DefaultGatewayNamespace,
+				},
+			}
+			Expect(env.Client().Get(env.Ctx, client.ObjectKeyFromObject(gateway), gateway)).To(Succeed())
+			gateway.Annotations[dns.DNSAnnotationKey] = "new.cluster.local"
+			Expect(env.Client().Update(env.Ctx, gateway)).To(Succeed())
+
+			reconciler.DNSMigrationGracePeriod = time.Hour
+
+			// both hostnames are routed, and the webhooks server is switched to the new hostname afterwards
+			reconcileResult := env.ShouldReconcile(req, "reconcile should start the migration")
+			Expect(reconcileResult.RequeueAfter).ToNot(BeZero())
+
+			Expect(env.Client().Get(env.Ctx, client.ObjectKeyFromObject(ls), ls)).To(Succeed())
+			Expect(ls.Status.DNS.BaseDomain).To(Equal("new.cluster.local"))
+			Expect(ls.Status.DNS.HostName).To(HaveSuffix(".new.cluster.local"))
+			Expect(ls.Status.DNS.Migration).ToNot(BeNil())
+			Expect(ls.Status.DNS.Migration.PreviousBaseDomain).To(Equal("openmcp.cluster.local"))
+			Expect(ls.Status.DNS.Migration.PreviousHostName).To(Equal(oldHostName))
+			Expect(ls.Status.DNS.Migration.Phase).To(Equal(v1alpha2.DNSMigrationPhaseSwitched))
+			newHostName := ls.Status.DNS.HostName
+
+			Expect(env.Client().Get(env.Ctx, client.ObjectKeyFromObject(tlsRoute), tlsRoute)).To(Succeed())
+			Expect(tlsRoute.Spec.Hostnames).To(ConsistOf(gatewayv1alpha2.Hostname(newHostName), gatewayv1alpha2.Hostname(oldHostName)))
+
+			Expect(env.Client().Get(env.Ctx, client.ObjectKeyFromObject(lsWebhooksServerDeployment), lsWebhooksServerDeployment)).To(Succeed())
+			Expect(lsWebhooksServerDeployment.Spec.Template.Spec.Containers[0].Args).To(ContainElement("--webhook-url=https://" + oldHostName + ":9443"))
+
+			// other instances published under the previous base domain are triggered
+			other := &v1alpha2.Landscaper{}
+			Expect(env.Client().Get(env.Ctx, client.ObjectKey{Name: "other", Namespace: "default"}, other)).To(Succeed())
+			Expect(other.Annotations).To(HaveKeyWithValue(v1alpha2.LandscaperOperation, v1alpha2.OperationReconcile))
+			unrelated := &v1alpha2.Landscaper{}
+			Expect(env.Client().Get(env.Ctx, client.ObjectKey{Name: "unrelated", Namespace: "default"}, unrelated)).To(Succeed())
+			Expect(unrelated.Annotations).ToNot(HaveKey(v1alpha2.LandscaperOperation))
+
+			env.ShouldReconcile(req, "reconcile should switch the webhooks server to the new hostname")
+			Expect(env.Client().Get(env.Ctx, client.ObjectKeyFromObject(lsWebhooksServerDeployment), lsWebhooksServerDeployment)).To(Succeed())
+			Expect(lsWebhooksServerDeployment.Spec.Template.Spec.Containers[0].Args).To(ContainElement("--webhook-url=https://" + newHostName + ":9443"))
+
+			// the instance is not ready until the webhooks server has registered the new hostname in the mcp cluster
+			setDeploymentsReady()
+			reconcileResult = env.ShouldReconcile(req, "reconcile should wait for the webhooks server registration")
+			Expect(env.Client().Get(env.Ctx, client.ObjectKeyFromObject(ls), ls)).To(Succeed())
+			Expect(ls.Status.Phase).To(Equal(v1alpha2.PhaseProgressing))
+			Expect(ls.Status.DNS.Migration).ToNot(BeNil())
+
+			// the previous hostname remains routed during the grace period
+			registerWebhooks(env.Ctx, env.Client(), newHostName)
+			reconcileResult = env.ShouldReconcile(req, "reconcile should wait for the grace period")
+			Expect(reconcileResult.RequeueAfter).To(BeNumerically(">", 50*time.Minute))
+
+			Expect(env.Client().Get(env.Ctx, client.ObjectKeyFromObject(ls), ls)).To(Succeed())
+			Expect(ls.Status.Phase).To(Equal(v1alpha2.PhaseReady))
+			Expect(ls.Status.DNS.Migration).ToNot(BeNil())
+			Expect(env.Client().Get(env.Ctx, client.ObjectKeyFromObject(tlsRoute), tlsRoute)).To(Succeed())
+			Expect(tlsRoute.Spec.Hostnames).To(HaveLen(2))
+
+			// once the grace period has expired, the previous hostname is removed
+			reconciler.DNSMigrationGracePeriod = 0
+			env.ShouldReconcile(req, "reconcile should finish the migration")
+
+			Expect(env.Client().Get(env.Ctx, client.ObjectKeyFromObject(ls), ls)).To(Succeed())
+			Expect(ls.Status.Phase).To(Equal(v1alpha2.PhaseReady))
+			Expect(ls.Status.DNS.BaseDomain).To(Equal("new.cluster.local"))
+			Expect(ls.Status.DNS.Migration).To(BeNil())
+			Expect(env.Client().Get(env.Ctx, client.ObjectKeyFromObject(tlsRoute), tlsRoute)).To(Succeed())
+			Expect(tlsRoute.Spec.Hostnames).To(ConsistOf(gatewayv1alpha2.Hostname(newHostName)))
+		})
+
+		It("should trigger the reconciliation when the base domain of the gateway changes", func() {
+			req := reconcile.Request{
+				NamespacedName: client.ObjectKey{
+					Name:      "test",
+					Namespace: "default",
+				},
+			}
+
+			accessRequestMCP, workloadClusterRequest, workloadAccessRequest := clusterAccessRequests(req)
+
+			ls := &v1alpha2.Landscaper{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      req.Name,
+					Namespace: req.Namespace,
+				},
+			}
+
+			identity.SetInstanceID(ls, identity.ComputeInstanceID(ls))
+			installationNamespace := identity.Instance(identity.GetInstanceID(ls)).Namespace()
+
+			tlsRoute := &gatewayv1alpha2.TLSRoute{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "webhooks-tls",
+					Namespace: installationNamespace,
+				},
+			}
+
+			env := buildTestEnvironmentReconcile("test-01", accessRequestMCP, workloadClusterRequest, workloadAccessRequest, tlsRoute)
+			reconciler, err := testutils.ReconcilerAs[*lscontroller.LandscaperReconciler](env)
+			Expect(err).NotTo(HaveOccurred())
+			reconciler.GatewayWatcher = lscontroller.NewGatewayWatcher(reconciler.OnboardingCluster)
+			watcherCtx, stopWatcher := context.WithCancel(env.Ctx)
+			DeferCleanup(stopWatcher)
+			go func() {
+				defer GinkgoRecover()
+				Expect(reconciler.GatewayWatcher.Start(watcherCtx)).To(Succeed())
+			}()
+
+			grantClusterAccess(env, req, accessRequestMCP, workloadClusterRequest, workloadAccessRequest)
+
+			env.ShouldReconcile(req, "reconcile should create the tls route and start watching the gateway")
+			Expect(env.Client().Get(env.Ctx, client.ObjectKeyFromObject(ls), ls)).To(Succeed())
+			Expect(ls.Annotations).ToNot(HaveKey(v1alpha2.LandscaperOperation))
+
+			// the watch is started asynchronously, so that the base domain is changed until the change is observed
+			gateway := &gatewayv1.Gateway{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      dns.DefaultGatewayName,
+					Namespace: dns.DefaultGatewayNamespace,
+				},
+			}
+			attempt := 0
+			Eventually(func(g Gomega) {
+				attempt++
+				g.Expect(env.Client().Get(env.Ctx, client.ObjectKeyFromObject(gateway), gateway)).To(Succeed())
+				gateway.Annotations[dns.DNSAnnotationKey] = "new-" + strconv.Itoa(attempt) + ".cluster.local"
+				g.Expect(env.Client().Update(env.Ctx, gateway)).To(Succeed())
+
+				g.Eventually(func(g Gomega) {
+					g.Expect(env.Client().Get(env.Ctx, client.ObjectKeyFromObject(ls), ls)).To(Succeed())
+					g.Expect(ls.Annotations).To(HaveKeyWithValue(v1alpha2.LandscaperOperation, v1alpha2.OperationReconcile))
+				}).WithTimeout(time.Second).Should(Succeed())
+			}).WithTimeout(20 * time.Second).Should(Succeed())
+		})
+
+		It("should register the webhooks server with the hostname of the internal base domain", func() {
+			req := reconcile.Request{
+				NamespacedName: client.ObjectKey{
+					Name:      "test",
+					Namespace: "default",
+				},
+			}
+
+			accessRequestMCP, workloadClusterRequest, workloadAccessRequest := clusterAccessRequests(req)
+
+			ls := &v1alpha2.Landscaper{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      req.Name,
+					Namespace: req.Namespace,
+				},
+			}
+
+			identity.SetInstanceID(ls, identity.ComputeInstanceID(ls))
+			installationNamespace := identity.Instance(identity.GetInstanceID(ls)).Namespace()
+
+			lsWebhooksServerDeployment := &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "landscaper-webhooks-server",
+					Namespace: installationNamespace,
+				},
+			}
+
+			tlsRoute := &gatewayv1alpha2.TLSRoute{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "webhooks-tls",
+					Namespace: installationNamespace,
+				},
+			}
+
+			env := buildTestEnvironmentReconcile("test-04", accessRequestMCP, workloadClusterRequest, workloadAccessRequest, lsWebhooksServerDeployment, tlsRoute)
+			grantClusterAccess(env, req, accessRequestMCP, workloadClusterRequest, workloadAccessRequest)
+
+			Expect(env.Client().Get(env.Ctx, client.ObjectKeyFromObject(ls), ls)).To(Succeed())
+			ls.Spec.WebhooksDNSScope = v1alpha2.DNSScopeInternal
+			Expect(env.Client().Update(env.Ctx, ls)).To(Succeed())
+
+			// the gateway has no internal base domain yet
+			env.ShouldNotReconcileWithError(req, MatchError(ContainSubstring("Internal")))
+			Expect(env.Client().Get(env.Ctx, client.ObjectKeyFromObject(ls), ls)).To(Succeed())
+			Expect(ls.Status.Conditions[0].Type).To(Equal(v1alpha2.ConditionTypeInstalled))
+			Expect(ls.Status.Conditions[0].Reason).To(Equal(v1alpha2.ConditionReasonDNSConfigFailed))
+
+			gateway := &gatewayv1.Gateway{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      dns.DefaultGatewayName,
+					Namespace: dns.DefaultGatewayNamespace,
+				},
+			}
+			Expect(env.Client().Get(env.Ctx, client.ObjectKeyFromObject(gateway), gateway)).To(Succeed())
+			gateway.Annotations[dns.InternalDNSAnnotationKey] = "internal.cluster.local"
+			Expect(env.Client().Update(env.Ctx, gateway)).To(Succeed())
+
+			env.ShouldReconcile(req, "reconcile should create the tls route")
+			setTLSRouteAccepted(env.Ctx, tlsRoute, env.Client())
+			env.ShouldReconcile(req, "reconcile should install the landscaper instance")
+
+			Expect(env.Client().Get(env.Ctx, client.ObjectKeyFromObject(ls), ls)).To(Succeed())
+			Expect(ls.Status.DNS).ToNot(BeNil())
+			Expect(ls.Status.DNS.Scope).To(Equal(v1alpha2.DNSScopeInternal))
+			Expect(ls.Status.DNS.BaseDomain).To(Equal("internal.cluster.local"))
+			Expect(ls.Status.DNS.HostName).To(HaveSuffix(".internal.cluster.local"))
+			Expect(ls.Status.DNS.Migration).To(BeNil())
+			Expect(ls.Status.DNS.HostNames).To(HaveLen(2))
+			Expect(ls.Status.DNS.HostNames[0].Scope).To(Equal(v1alpha2.DNSScopeExternal))
+			Expect(ls.Status.DNS.HostNames[0].HostName).To(HaveSuffix(".openmcp.cluster.local"))
+			Expect(ls.Status.DNS.HostNames[1].Scope).To(Equal(v1alpha2.DNSScopeInternal))
+			Expect(ls.Status.DNS.HostNames[1].HostName).To(Equal(ls.Status.DNS.HostName))
+
+			// both hostnames are routed, but the webhooks server is registered with the internal one
+			Expect(env.Client().Get(env.Ctx, client.ObjectKeyFromObject(tlsRoute), tlsRoute)).To(Succeed())
+			Expect(tlsRoute.Spec.Hostnames).To(ConsistOf(
+				gatewayv1alpha2.Hostname(ls.Status.DNS.HostNames[0].HostName),
+				gatewayv1alpha2.Hostname(ls.Status.DNS.HostNames[1].HostName)))
+
+			Expect(env.Client().Get(env.Ctx, client.ObjectKeyFromObject(lsWebhooksServerDeployment), lsWebhooksServerDeployment)).To(Succeed())
+			Expect(lsWebhooksServerDeployment.Spec.Template.Spec.Containers[0].Args).To(ContainElement("--webhook-url=https://" + ls.Status.DNS.HostName + ":9443"))
+		})
+
+		It("should install and uninstall the selected additional deployers", func() {
+			req := reconcile.Request{
+				NamespacedName: client.ObjectKey{
+					Name:      "test",
+					Namespace: "default",
+				},
+			}
+
+			accessRequestMCP, workloadClusterRequest, workloadAccessRequest := clusterAccessRequests(req)
+
+			ls := &v1alpha2.Landscaper{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      req.Name,
+					Namespace: req.Namespace,
+				},
+			}
+
+			identity.SetInstanceID(ls, identity.ComputeInstanceID(ls))
+			installationNamespace := identity.Instance(identity.GetInstanceID(ls)).Namespace()
+
+			containerDeployerDeployment := &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "container-deployer",
+					Namespace: installationNamespace,
+				},
+			}
+
+			mockDeployerDeployment := &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "mock-deployer",
+					Namespace: installationNamespace,
+				},
+			}
+
+			tlsRoute := &gatewayv1alpha2.TLSRoute{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "webhooks-tls",
+					Namespace: installationNamespace,
+				},
+			}
+
+			env := buildTestEnvironmentReconcile("test-01", accessRequestMCP, workloadClusterRequest, workloadAccessRequest, tlsRoute)
+			grantClusterAccess(env, req, accessRequestMCP, workloadClusterRequest, workloadAccessRequest)
+
+			Expect(env.Client().Get(env.Ctx, client.ObjectKeyFromObject(ls), ls)).To(Succeed())
+			ls.Spec.Deployers = []string{v1alpha2.DeployerContainer, v1alpha2.DeployerMock}
+			Expect(env.Client().Update(env.Ctx, ls)).To(Succeed())
+
+			// the deployers are not yet offered in the provider config
+			env.ShouldNotReconcileWithError(req, MatchError(ContainSubstring("not offered")))
+			Expect(env.Client().Get(env.Ctx, client.ObjectKeyFromObject(ls), ls)).To(Succeed())
+			Expect(ls.Status.Conditions[0].Type).To(Equal(v1alpha2.ConditionTypeInstalled))
+			Expect(ls.Status.Conditions[0].Reason).To(Equal(v1alpha2.ConditionReasonProviderConfigError))
+
+			providerConfig := &v1alpha2.ProviderConfig{}
+			Expect(env.Client().Get(env.Ctx, client.ObjectKey{Name: "default"}, providerConfig)).To(Succeed())
+			providerConfig.Spec.Deployment.Deployers = []v1alpha2.DeployerOffering{
+				{Name: v1alpha2.DeployerContainer},
+				{Name: v1alpha2.DeployerMock},
+			}
+			Expect(env.Client().Update(env.Ctx, providerConfig)).To(Succeed())
+
+			env.ShouldReconcile(req, "reconcile should create the tls route")
+			setTLSRouteAccepted(env.Ctx, tlsRoute, env.Client())
+			env.ShouldReconcile(req, "reconcile should install the landscaper instance")
+
+			Expect(env.Client().Get(env.Ctx, client.ObjectKeyFromObject(ls), ls)).To(Succeed())
+			Expect(ls.Status.Deployers).To(Equal([]string{v1alpha2.DeployerContainer, v1alpha2.DeployerMock}))
+
+			Expect(env.Client().Get(env.Ctx, client.ObjectKeyFromObject(containerDeployerDeployment), containerDeployerDeployment)).To(Succeed())
+			Expect(containerDeployerDeployment.Spec.Template.Spec.Containers[0].Image).To(Equal(
+				"registry.test/components/" + v1alpha2.ContainerDeployerImageLocation + ":v0.135.0"))
+			Expect(env.Client().Get(env.Ctx, client.ObjectKeyFromObject(mockDeployerDeployment), mockDeployerDeployment)).To(Succeed())
+
+			// the deployers are registered in the health checks of the landscaper
+			configSecret := &corev1.Secret{}
+			Expect(env.Client().Get(env.Ctx, client.ObjectKey{Name: "landscaper-controller-config", Namespace: installationNamespace}, configSecret)).To(Succeed())
+			Expect(string(configSecret.Data["config.yaml"])).To(ContainSubstring("- container-deployer"))
+			Expect(string(configSecret.Data["config.yaml"])).To(ContainSubstring("- mock-deployer"))
+
+			// deselect the mock deployer
+			ls.Spec.Deployers = []string{v1alpha2.DeployerContainer}
+			Expect(env.Client().Update(env.Ctx, ls)).To(Succeed())
+			env.ShouldReconcile(req, "reconcile should uninstall the mock deployer")
+
+			Expect(env.Client().Get(env.Ctx, client.ObjectKeyFromObject(ls), ls)).To(Succeed())
+			Expect(ls.Status.Deployers).To(Equal([]string{v1alpha2.DeployerContainer}))
+			Expect(env.Client().Get(env.Ctx, client.ObjectKeyFromObject(containerDeployerDeployment), containerDeployerDeployment)).To(Succeed())
+			Expect(env.Client().Get(env.Ctx, client.ObjectKeyFromObject(mockDeployerDeployment), mockDeployerDeployment)).ToNot(Succeed())
+
+			Expect(env.Client().Get(env.Ctx, client.ObjectKey{Name: "landscaper-controller-config", Namespace: installationNamespace}, configSecret)).To(Succeed())
+			Expect(string(configSecret.Data["config.yaml"])).ToNot(ContainSubstring("- mock-deployer"))
+		})
+
+		It("should uninstall the helm deployer when it is disabled", func() {
+			req := reconcile.Request{
+				NamespacedName: client.ObjectKey{
+					Name:      "test",
+					Namespace: "default",
+				},
+			}
+
+			accessRequestMCP, workloadClusterRequest, workloadAccessRequest := clusterAccessRequests(req)
+
+			ls := &v1alpha2.Landscaper{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      req.Name,
+					Namespace: req.Namespace,
+				},
+			}
+
+			identity.SetInstanceID(ls, identity.ComputeInstanceID(ls))
+			installationNamespace := identity.Instance(identity.GetInstanceID(ls)).Namespace()
+
+			helmDeployerDeployment := &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "helm-deployer",
+					Namespace: installationNamespace,
+				},
+			}
+
+			manifestDeployerDeployment := &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "manifest-deployer",
+					Namespace: installationNamespace,
+				},
+			}
+
+			tlsRoute := &gatewayv1alpha2.TLSRoute{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "webhooks-tls",
+					Namespace: installationNamespace,
+				},
+			}
+
+			env := buildTestEnvironmentReconcile("test-01", accessRequestMCP, workloadClusterRequest, workloadAccessRequest, tlsRoute)
+			grantClusterAccess(env, req, accessRequestMCP, workloadClusterRequest, workloadAccessRequest)
+
+			env.ShouldReconcile(req, "reconcile should create the tls route")
+			setTLSRouteAccepted(env.Ctx, tlsRoute, env.Client())
+			env.ShouldReconcile(req, "reconcile should install the landscaper instance")
+
+			Expect(env.Client().Get(env.Ctx, client.ObjectKeyFromObject(helmDeployerDeployment), helmDeployerDeployment)).To(Succeed())
+			Expect(env.Client().Get(env.Ctx, client.ObjectKeyFromObject(manifestDeployerDeployment), manifestDeployerDeployment)).To(Succeed())
+
+			// a previous uninstallation of the helm deployer may have stopped after deleting its deployment
+			helmDeployerConfig := &corev1.Secret{}
+			Expect(env.Client().Get(env.Ctx, client.ObjectKey{Name: "helm-deployer-config", Namespace: installationNamespace}, helmDeployerConfig)).To(Succeed())
+			Expect(env.Client().Delete(env.Ctx, helmDeployerDeployment)).To(Succeed())
+
+			// disable the helm deployer
+			Expect(env.Client().Get(env.Ctx, client.ObjectKeyFromObject(ls), ls)).To(Succeed())
+			ls.Spec.HelmDeployer = &v1alpha2.HelmDeployerSpec{DeployerSpec: v1alpha2.DeployerSpec{Disabled: true}}
+			Expect(env.Client().Update(env.Ctx, ls)).To(Succeed())
+			env.ShouldReconcile(req, "reconcile should uninstall the helm deployer")
+
+			Expect(env.Client().Get(env.Ctx, client.ObjectKeyFromObject(helmDeployerDeployment), helmDeployerDeployment)).ToNot(Succeed())
+			Expect(env.Client().Get(env.Ctx, client.ObjectKeyFromObject(helmDeployerConfig), helmDeployerConfig)).ToNot(Succeed())
+			Expect(env.Client().Get(env.Ctx, client.ObjectKeyFromObject(manifestDeployerDeployment), manifestDeployerDeployment)).To(Succeed())
+
+			// the helm deployer is no longer registered in the health checks of the landscaper
+			configSecret := &corev1.Secret{}
+			Expect(env.Client().Get(env.Ctx, client.ObjectKey{Name: "landscaper-controller-config", Namespace: installationNamespace}, configSecret)).To(Succeed())
+			Expect(string(configSecret.Data["config.yaml"])).ToNot(ContainSubstring("- helm-deployer"))
+			Expect(string(configSecret.Data["config.yaml"])).To(ContainSubstring("- manifest-deployer"))
+		})
+
+		It("should remove the webhooks server when all webhooks are disabled", func() {
+			req := reconcile.Request{
+				NamespacedName: client.ObjectKey{
+					Name:      "test",
+					Namespace: "default",
+				},
+			}
+
+			accessRequestMCP, workloadClusterRequest, workloadAccessRequest := clusterAccessRequests(req)
+
+			ls := &v1alpha2.Landscaper{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      req.Name,
+					Namespace: req.Namespace,
+				},
+			}
+
+			identity.SetInstanceID(ls, identity.ComputeInstanceID(ls))
+			installationNamespace := identity.Instance(identity.GetInstanceID(ls)).Namespace()
+
+			deployments := []*appsv1.Deployment{}
+			for _, name := range []string{"landscaper-controller", "landscaper-controller-main", "landscaper-webhooks-server", "manifest-deployer", "helm-deployer"} {
+				deployments = append(deployments, &appsv1.Deployment{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      name,
+						Namespace: installationNamespace,
+					},
+				})
+			}
+			lsWebhooksServerDeployment := deployments[2]
+
+			tlsRoute := &gatewayv1alpha2.TLSRoute{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "webhooks-tls",
+					Namespace: installationNamespace,
+				},
+			}
+
+			objectsWithStatus := []client.Object{accessRequestMCP, workloadClusterRequest, workloadAccessRequest, tlsRoute}
+			for _, deployment := range deployments {
+				objectsWithStatus = append(objectsWithStatus, deployment)
+			}
+
+			env := buildTestEnvironmentReconcile("test-01", objectsWithStatus...)
+
+			setDeploymentsReady := func() {
+				for _, deployment := range deployments {
+					if err := env.Client().Get(env.Ctx, client.ObjectKeyFromObject(deployment), deployment); err == nil {
+						setDeploymentReady(env.Ctx, deployment, env.Client())
+					}
+				}
+			}
+
+			grantClusterAccess(env, req, accessRequestMCP, workloadClusterRequest, workloadAccessRequest)
+
+			env.ShouldReconcile(req, "reconcile should create the tls route")
+			setTLSRouteAccepted(env.Ctx, tlsRoute, env.Client())
+			env.ShouldReconcile(req, "reconcile should install the landscaper instance")
+			setDeploymentsReady()
+			Expect(env.Client().Get(env.Ctx, client.ObjectKeyFromObject(ls), ls)).To(Succeed())
+			registerWebhooks(env.Ctx, env.Client(), ls.Status.DNS.HostName)
+			env.ShouldReconcile(req, "reconcile should set the landscaper instance to ready")
+
+			Expect(env.Client().Get(env.Ctx, client.ObjectKeyFromObject(ls), ls)).To(Succeed())
+			Expect(ls.Status.Phase).To(Equal(v1alpha2.PhaseReady))
+			Expect(ls.Status.DNS).ToNot(BeNil())
+			Expect(env.Client().Get(env.Ctx, client.ObjectKey{Name: "webhooks-tls", Namespace: installationNamespace}, &corev1.Service{})).To(Succeed())
+
+			// disable all webhooks
+			ls.Spec.DisableWebhooks = []v1alpha2.Webhook{v1alpha2.WebhookInstallation, v1alpha2.WebhookExecution, v1alpha2.WebhookDeployItem}
+			Expect(env.Client().Update(env.Ctx, ls)).To(Succeed())
+			env.ShouldReconcile(req, "reconcile should remove the webhooks server")
+
+			Expect(env.Client().Get(env.Ctx, client.ObjectKeyFromObject(lsWebhooksServerDeployment), lsWebhooksServerDeployment)).ToNot(Succeed())
+			Expect(env.Client().Get(env.Ctx, client.ObjectKey{Name: "webhooks-tls", Namespace: installationNamespace}, &corev1.Service{})).ToNot(Succeed())
+			Expect(env.Client().Get(env.Ctx, client.ObjectKeyFromObject(tlsRoute), tlsRoute)).ToNot(Succeed())
+			Expect(env.Client().Get(env.Ctx, client.ObjectKey{Name: "landscaper-validation-webhook"}, &admissionv1.ValidatingWebhookConfiguration{})).ToNot(Succeed())
+
+			Expect(env.Client().Get(env.Ctx, client.ObjectKeyFromObject(ls), ls)).To(Succeed())
+			Expect(ls.Status.Phase).To(Equal(v1alpha2.PhaseReady))
+			Expect(ls.Status.DNS).To(BeNil())
+			Expect(ls.Status.Conditions).ToNot(ContainElement(HaveField("Type", v1alpha2.ConditionTypeWebhookReachable)))
+
+			// without webhooks, the landscaper instance does not need a gateway
+			gateway := &gatewayv1.Gateway{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      dns.DefaultGatewayName,
+					Namespace: dns.DefaultGatewayNamespace,
+				},
+			}
+			Expect(env.Client().Delete(env.Ctx, gateway)).To(Succeed())
+			env.ShouldReconcile(req, "reconcile should not require a gateway")
+
+			Expect(env.Client().Get(env.Ctx, client.ObjectKeyFromObject(ls), ls)).To(Succeed())
+			Expect(ls.Status.Phase).To(Equal(v1alpha2.PhaseReady))
+		})
+
+		It("should configure the OCI registries of the helm deployer", func() {
+			req := reconcile.Request{
+				NamespacedName: client.ObjectKey{
+					Name:      "test",
+					Namespace: "default",
+				},
+			}
+
+			accessRequestMCP, workloadClusterRequest, workloadAccessRequest := clusterAccessRequests(req)
+
+			ls := &v1alpha2.Landscaper{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      req.Name,
+					Namespace: req.Namespace,
+				},
+			}
+
+			identity.SetInstanceID(ls, identity.ComputeInstanceID(ls))
+			installationNamespace := identity.Instance(identity.GetInstanceID(ls)).Namespace()
+
+			tlsRoute := &gatewayv1alpha2.TLSRoute{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "webhooks-tls",
+					Namespace: installationNamespace,
+				},
+			}
+
+			env := buildTestEnvironmentReconcile("test-01", accessRequestMCP, workloadClusterRequest, workloadAccessRequest, tlsRoute)
+			grantClusterAccess(env, req, accessRequestMCP, workloadClusterRequest, workloadAccessRequest)
+
+			registrySecret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "registry-credentials",
+					Namespace: req.Namespace,
+				},
+				Type: corev1.SecretTypeDockerConfigJson,
+				Data: map[string][]byte{
+					corev1.DockerConfigJsonKey: []byte(`{"auths":{"registry.example.com":{"auth":"dXNlcjpvbGQ="}}}`),
+				},
+			}
+			Expect(env.Client().Create(env.Ctx, registrySecret)).To(Succeed())
+
+			Expect(env.Client().Get(env.Ctx, client.ObjectKeyFromObject(ls), ls)).To(Succeed())
+			ls.Spec.HelmDeployer = &v1alpha2.HelmDeployerSpec{
+				Registries: []v1alpha2.OCIRegistry{
+					{
+						Host:           "registry.example.com",
+						SecretRef:      &v1alpha2.SourceReference{Name: registrySecret.Name, Source: v1alpha2.SourceOnboarding},
+						AllowPlainHTTP: true,
+					},
+					{
+						Host:      "registry.platform.example.com",
+						SecretRef: &v1alpha2.SourceReference{Name: "platform-registry"},
+					},
+				},
+			}
+			Expect(env.Client().Update(env.Ctx, ls)).To(Succeed())
+
+			// the platform secret is not offered in the provider config
+			env.ShouldNotReconcileWithError(req, MatchError(ContainSubstring("not offered as registry secret")))
+
+			Expect(env.Client().Get(env.Ctx, client.ObjectKeyFromObject(ls), ls)).To(Succeed())
+			ls.Spec.HelmDeployer.Registries = ls.Spec.HelmDeployer.Registries[:1]
+			Expect(env.Client().Update(env.Ctx, ls)).To(Succeed())
+
+			env.ShouldReconcile(req, "reconcile should create the tls route")
+			setTLSRouteAccepted(env.Ctx, tlsRoute, env.Client())
+			env.ShouldReconcile(req, "reconcile should install the landscaper instance")
+
+			registriesSecret := &corev1.Secret{}
+			Expect(env.Client().Get(env.Ctx, client.ObjectKey{Name: "helm-deployer-registries", Namespace: installationNamespace}, registriesSecret)).To(Succeed())
+			Expect(registriesSecret.Data).To(HaveKeyWithValue("onboarding-registry-credentials.json", registrySecret.Data[corev1.DockerConfigJsonKey]))
+
+			configSecret := &corev1.Secret{}
+			Expect(env.Client().Get(env.Ctx, client.ObjectKey{Name: "helm-deployer-config", Namespace: installationNamespace}, configSecret)).To(Succeed())
+			Expect(string(configSecret.Data["config.yaml"])).To(ContainSubstring("onboarding-registry-credentials.json"))
+			Expect(string(configSecret.Data["config.yaml"])).To(ContainSubstring("allowPlainHttp: true"))
+
+			helmDeployerDeployment := &appsv1.Deployment{}
+			Expect(env.Client().Get(env.Ctx, client.ObjectKey{Name: "helm-deployer", Namespace: installationNamespace}, helmDeployerDeployment)).To(Succeed())
+			checksum := helmDeployerDeployment.Spec.Template.Annotations["checksum/registrysecrets"]
+
+			// rotate the credentials
+			registrySecret.Data[corev1.DockerConfigJsonKey] = []byte(`{"auths":{"registry.example.com":{"auth":"dXNlcjpuZXc="}}}`)
+			Expect(env.Client().Update(env.Ctx, registrySecret)).To(Succeed())
+			env.ShouldReconcile(req, "reconcile should roll out the rotated credentials")
+
+			Expect(env.Client().Get(env.Ctx, client.ObjectKeyFromObject(registriesSecret), registriesSecret)).To(Succeed())
+			Expect(registriesSecret.Data).To(HaveKeyWithValue("onboarding-registry-credentials.json", registrySecret.Data[corev1.DockerConfigJsonKey]))
+			Expect(env.Client().Get(env.Ctx, client.ObjectKeyFromObject(helmDeployerDeployment), helmDeployerDeployment)).To(Succeed())
+			Expect(helmDeployerDeployment.Spec.Template.Annotations["checksum/registrysecrets"]).ToNot(Equal(checksum))
+		})
+
+		It("should configure the OCI registries of the landscaper", func() {
+			req := reconcile.Request{
+				NamespacedName: client.ObjectKey{
+					Name:      "test",
+					Namespace: "default",
+				},
+			}
+
+			accessRequestMCP, workloadClusterRequest, workloadAccessRequest := clusterAccessRequests(req)
+
+			ls := &v1alpha2.Landscaper{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      req.Name,
+					Namespace: req.Namespace,
+				},
+			}
+
+			identity.SetInstanceID(ls, identity.ComputeInstanceID(ls))
+			installationNamespace := identity.Instance(identity.GetInstanceID(ls)).Namespace()
+
+			tlsRoute := &gatewayv1alpha2.TLSRoute{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "webhooks-tls",
+					Namespace: installationNamespace,
+				},
+			}
+
+			env := buildTestEnvironmentReconcile("test-01", accessRequestMCP, workloadClusterRequest, workloadAccessRequest, tlsRoute)
+			grantClusterAccess(env, req, accessRequestMCP, workloadClusterRequest, workloadAccessRequest)
+
+			newRegistrySecret := func(name, namespace string) *corev1.Secret {
+				return &corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      name,
+						Namespace: namespace,
+					},
+					Type: corev1.SecretTypeDockerConfigJson,
+					Data: map[string][]byte{
+						corev1.DockerConfigJsonKey: []byte(`{"auths":{"` + name + `.example.com":{"auth":"dXNlcjpwYXNz"}}}`),
+					},
+				}
+			}
+			mirrorSecret := newRegistrySecret("mirror", "openmcp-system")
+			tenantSecret := newRegistrySecret("tenant", req.Namespace)
+			Expect(env.Client().Create(env.Ctx, mirrorSecret)).To(Succeed())
+			Expect(env.Client().Create(env.Ctx, tenantSecret)).To(Succeed())
+
+			sizeLimit := resource.MustParse("1Gi")
+			providerConfig := &v1alpha2.ProviderConfig{}
+			Expect(env.Client().Get(env.Ctx, client.ObjectKey{Name: "default"}, providerConfig)).To(Succeed())
+			providerConfig.Spec.Registry = &v1alpha2.ProviderRegistrySpec{
+				Registries: []v1alpha2.OCIRegistry{
+					{
+						Host:      "mirror.example.com",
+						SecretRef: &v1alpha2.SourceReference{Name: mirrorSecret.Name},
+					},
+				},
+				Cache: &v1alpha2.OCICacheSpec{SizeLimit: &sizeLimit},
+			}
+			Expect(env.Client().Update(env.Ctx, providerConfig)).To(Succeed())
+
+			Expect(env.Client().Get(env.Ctx, client.ObjectKeyFromObject(ls), ls)).To(Succeed())
+			ls.Spec.Registry = &v1alpha2.RegistrySpec{
+				Registries: []v1alpha2.OCIRegistry{
+					{
+						Host:      "tenant.example.com",
+						SecretRef: &v1alpha2.SourceReference{Name: tenantSecret.Name, Source: v1alpha2.SourceOnboarding},
+					},
+					{
+						Host:           "localhost:5000",
+						AllowPlainHTTP: true,
+					},
+				},
+			}
+			Expect(env.Client().Update(env.Ctx, ls)).To(Succeed())
+
+			env.ShouldReconcile(req, "reconcile should create the tls route")
+			setTLSRouteAccepted(env.Ctx, tlsRoute, env.Client())
+			env.ShouldReconcile(req, "reconcile should install the landscaper instance")
+
+			registriesSecret := &corev1.Secret{}
+			Expect(env.Client().Get(env.Ctx, client.ObjectKey{Name: "landscaper-controller-main-registries", Namespace: installationNamespace}, registriesSecret)).To(Succeed())
+			Expect(registriesSecret.Data).To(HaveKeyWithValue("platform-mirror.json", mirrorSecret.Data[corev1.DockerConfigJsonKey]))
+			Expect(registriesSecret.Data).To(HaveKeyWithValue("onboarding-tenant.json", tenantSecret.Data[corev1.DockerConfigJsonKey]))
+
+			configSecret := &corev1.Secret{}
+			Expect(env.Client().Get(env.Ctx, client.ObjectKey{Name: "landscaper-controller-config", Namespace: installationNamespace}, configSecret)).To(Succeed())
+			Expect(string(configSecret.Data["config.yaml"])).To(ContainSubstring("platform-mirror.json"))
+			Expect(string(configSecret.Data["config.yaml"])).To(ContainSubstring("onboarding-tenant.json"))
+			Expect(string(configSecret.Data["config.yaml"])).To(ContainSubstring("allowPlainHttp: true"))
+
+			mainDeployment := &appsv1.Deployment{}
+			Expect(env.Client().Get(env.Ctx, client.ObjectKey{Name: "landscaper-controller-main", Namespace: installationNamespace}, mainDeployment)).To(Succeed())
+			Expect(mainDeployment.Spec.Template.Spec.Volumes).To(ContainElement(And(
+				HaveField("Name", "oci-cache"),
+				HaveField("VolumeSource.EmptyDir.SizeLimit", HaveValue(Equal(sizeLimit))),
+			)))
+
+			// tenants can only reference platform secrets which are offered in the provider config
+			Expect(env.Client().Get(env.Ctx, client.ObjectKeyFromObject(ls), ls)).To(Succeed())
+			ls.Spec.Registry.Registries[0].SecretRef = &v1alpha2.SourceReference{Name: mirrorSecret.Name}
+			Expect(env.Client().Update(env.Ctx, ls)).To(Succeed())
+			env.ShouldNotReconcileWithError(req, MatchError(ContainSubstring("not offered as registry secret")))
+
+			// tenants can neither select a namespace nor the external secret store
+			Expect(env.Client().Get(env.Ctx, client.ObjectKeyFromObject(ls), ls)).To(Succeed())
+			ls.Spec.Registry.Registries[0].SecretRef = &v1alpha2.SourceReference{Name: mirrorSecret.Name, Namespace: "openmcp-system"}
+			Expect(env.Client().Update(env.Ctx, ls)).To(Succeed())
+			env.ShouldNotReconcileWithError(req, MatchError(ContainSubstring("must not specify a namespace")))
+
+			Expect(env.Client().Get(env.Ctx, client.ObjectKeyFromObject(ls), ls)).To(Succeed())
+			ls.Spec.Registry.Registries[0].SecretRef = &v1alpha2.SourceReference{Name: mirrorSecret.Name, Source: v1alpha2.SourceExternal}
+			Expect(env.Client().Update(env.Ctx, ls)).To(Succeed())
+			env.ShouldNotReconcileWithError(req, MatchError(ContainSubstring("only available through the registry secrets")))
+		})
+
+		It("should read the synced secrets and config maps from their sources", func() {
+			req := reconcile.Request{
+				NamespacedName: client.ObjectKey{
+					Name:      "test",
+					Namespace: "default",
+				},
+			}
+
+			accessRequestMCP, workloadClusterRequest, workloadAccessRequest := clusterAccessRequests(req)
+
+			ls := &v1alpha2.Landscaper{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      req.Name,
+					Namespace: req.Namespace,
+				},
+			}
+
+			identity.SetInstanceID(ls, identity.ComputeInstanceID(ls))
+			installationNamespace := identity.Instance(identity.GetInstanceID(ls)).Namespace()
+
+			tlsRoute := &gatewayv1alpha2.TLSRoute{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "webhooks-tls",
+					Namespace: installationNamespace,
+				},
+			}
+
+			env := buildTestEnvironmentReconcile("test-01", accessRequestMCP, workloadClusterRequest, workloadAccessRequest, tlsRoute)
+			grantClusterAccess(env, req, accessRequestMCP, workloadClusterRequest, workloadAccessRequest)
+
+			dockerConfig := func(host string) []byte {
+				return []byte(`{"auths":{"` + host + `":{"auth":"dXNlcjpwYXNz"}}}`)
+			}
+
+			// the external secret store contains a directory with a file per key for each secret or config map
+			storeDir := GinkgoT().TempDir()
+			writeToStore := func(name, key string, value []byte) {
+				Expect(os.MkdirAll(filepath.Join(storeDir, name), 0o700)).To(Succeed())
+				Expect(os.WriteFile(filepath.Join(storeDir, name, key), value, 0o600)).To(Succeed())
+			}
+			writeToStore("controller-pull-secret", corev1.DockerConfigJsonKey, dockerConfig("external.example.com"))
+			writeToStore("shared-registry", corev1.DockerConfigJsonKey, dockerConfig("shared.example.com"))
+			writeToStore("ca-bundle", "ca.crt", []byte("external-ca"))
+
+			r, err := testutils.ReconcilerAs[*lscontroller.LandscaperReconciler](env)
+			Expect(err).NotTo(HaveOccurred())
+			r.ExternalSecretStore = &sources.FileSource{Dir: storeDir}
+
+			// secrets of another namespace on the platform cluster, and of the tenant on the onboarding cluster
+			Expect(env.Client().Create(env.Ctx, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "registry-secrets"}})).To(Succeed())
+			for _, secret := range []*corev1.Secret{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "helm-pull-secret", Namespace: "registry-secrets"},
+					Type:       corev1.SecretTypeDockerConfigJson,
+					Data:       map[string][]byte{corev1.DockerConfigJsonKey: dockerConfig("platform.example.com")},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "tenant-pull-secret", Namespace: req.Namespace},
+					Type:       corev1.SecretTypeDockerConfigJson,
+					Data:       map[string][]byte{corev1.DockerConfigJsonKey: dockerConfig("tenant.example.com")},
+				},
+			} {
+				Expect(env.Client().Create(env.Ctx, secret)).To(Succeed())
+			}
+
+			providerConfig := &v1alpha2.ProviderConfig{}
+			Expect(env.Client().Get(env.Ctx, client.ObjectKey{Name: "default"}, providerConfig)).To(Succeed())
+			providerConfig.Spec.CABundleRef = &v1alpha2.SourceKeyReference{
+				SourceReference: v1alpha2.SourceReference{Name: "ca-bundle", Source: v1alpha2.SourceExternal},
+				Key:             "ca.crt",
+			}
+			providerConfig.Spec.Deployment.LandscaperController = &v1alpha2.ImageConfiguration{
+				Image:            "registry.test/landscaper/landscaper-controller",
+				ImagePullSecrets: []v1alpha2.SourceReference{{Name: "controller-pull-secret", Source: v1alpha2.SourceExternal}},
+			}
+			providerConfig.Spec.Deployment.HelmDeployer = &v1alpha2.ImageConfiguration{
+				Image:            "registry.test/landscaper/helm-deployer",
+				ImagePullSecrets: []v1alpha2.SourceReference{{Name: "helm-pull-secret", Namespace: "registry-secrets"}},
+			}
+			providerConfig.Spec.Deployment.ManifestDeployer = &v1alpha2.ImageConfiguration{
+				Image:            "registry.test/landscaper/manifest-deployer",
+				ImagePullSecrets: []v1alpha2.SourceReference{{Name: "tenant-pull-secret", Source: v1alpha2.SourceOnboarding}},
+			}
+			providerConfig.Spec.RegistrySecrets = []v1alpha2.SourceReference{{Name: "shared-registry", Source: v1alpha2.SourceExternal}}
+			Expect(env.Client().Update(env.Ctx, providerConfig)).To(Succeed())
+
+			Expect(env.Client().Get(env.Ctx, client.ObjectKeyFromObject(ls), ls)).To(Succeed())
+			ls.Spec.Registry = &v1alpha2.RegistrySpec{
+				Registries: []v1alpha2.OCIRegistry{
+					{
+						Host:      "shared.example.com",
+						SecretRef: &v1alpha2.SourceReference{Name: "shared-registry"},
+					},
+				},
+			}
+			Expect(env.Client().Update(env.Ctx, ls)).To(Succeed())
+
+			env.ShouldReconcile(req, "reconcile should create the tls route")
+			setTLSRouteAccepted(env.Ctx, tlsRoute, env.Client())
+			env.ShouldReconcile(req, "reconcile should install the landscaper instance")
+
+			expectImagePullSecret := func(deploymentName string, content []byte) {
+				deployment := &appsv1.Deployment{}
+				Expect(env.Client().Get(env.Ctx, client.ObjectKey{Name: deploymentName, Namespace: installationNamespace}, deployment)).To(Succeed())
+				Expect(deployment.Spec.Template.Spec.ImagePullSecrets).To(HaveLen(1))
+				secret := &corev1.Secret{}
+				Expect(env.Client().Get(env.Ctx, client.ObjectKey{Name: deployment.Spec.Template.Spec.ImagePullSecrets[0].Name, Namespace: installationNamespace}, secret)).To(Succeed())
+				Expect(secret.Data).To(HaveKeyWithValue(corev1.DockerConfigJsonKey, content))
+			}
+			expectImagePullSecret("landscaper-controller", dockerConfig("external.example.com"))
+			expectImagePullSecret("helm-deployer", dockerConfig("platform.example.com"))
+			expectImagePullSecret("manifest-deployer", dockerConfig("tenant.example.com"))
+
+			caConfigMap := &corev1.ConfigMap{}
+			Expect(env.Client().Get(env.Ctx, client.ObjectKey{Name: "ca-bundle", Namespace: installationNamespace}, caConfigMap)).To(Succeed())
+			Expect(caConfigMap.Data).To(HaveKeyWithValue("ca.crt", "external-ca"))
+
+			registriesSecret := &corev1.Secret{}
+			Expect(env.Client().Get(env.Ctx, client.ObjectKey{Name: "landscaper-controller-main-registries", Namespace: installationNamespace}, registriesSecret)).To(Succeed())
+			Expect(registriesSecret.Data).To(HaveKeyWithValue("external-shared-registry.json", dockerConfig("shared.example.com")))
+
+			// without external secret store, references to it cannot be resolved
+			r.ExternalSecretStore = nil
+			env.ShouldNotReconcileWithError(req, MatchError(ContainSubstring("no external secret store is configured")))
+		})
+
+		It("should apply the controller configuration within the bounds of the provider config", func() {
+			req := reconcile.Request{
+				NamespacedName: client.ObjectKey{
+					Name:      "test",
+					Namespace: "default",
+				},
+			}
+
+			accessRequestMCP, workloadClusterRequest, workloadAccessRequest := clusterAccessRequests(req)
+
+			ls := &v1alpha2.Landscaper{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      req.Name,
+					Namespace: req.Namespace,
+				},
+			}
+
+			identity.SetInstanceID(ls, identity.ComputeInstanceID(ls))
+			installationNamespace := identity.Instance(identity.GetInstanceID(ls)).Namespace()
+
+			tlsRoute := &gatewayv1alpha2.TLSRoute{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "webhooks-tls",
+					Namespace: installationNamespace,
+				},
+			}
+
+			env := buildTestEnvironmentReconcile("test-01", accessRequestMCP, workloadClusterRequest, workloadAccessRequest, tlsRoute)
+			grantClusterAccess(env, req, accessRequestMCP, workloadClusterRequest, workloadAccessRequest)
+
+			providerConfig := &v1alpha2.ProviderConfig{}
+			Expect(env.Client().Get(env.Ctx, client.ObjectKey{Name: "default"}, providerConfig)).To(Succeed())
+			providerConfig.Spec.ConfigurationBounds = &v1alpha2.ConfigurationBounds{
+				MaxWorkers:           ptr.To[int32](20),
+				MaxDeployItemTimeout: &metav1.Duration{Duration: 2 * time.Hour},
+			}
+			Expect(env.Client().Update(env.Ctx, providerConfig)).To(Succeed())
+
+			Expect(env.Client().Get(env.Ctx, client.ObjectKeyFromObject(ls), ls)).To(Succeed())
+			ls.Spec.Configuration = &v1alpha2.ControllerConfiguration{
+				DeployItemTimeouts: &v1alpha2.DeployItemTimeouts{
+					Pickup: &metav1.Duration{Duration: 3 * time.Hour},
+					Abort:  &metav1.Duration{Duration: 5 * time.Minute},
+				},
+				ExcludedNamespaces: []string{"tenant-system"},
+				Workers: &v1alpha2.ControllerWorkers{
+					Installations: ptr.To[int32](50),
+				},
+				Verbosity: v1alpha2.VerbosityDebug,
+			}
+			Expect(env.Client().Update(env.Ctx, ls)).To(Succeed())
+
+			// the workers and the pickup timeout exceed the bounds
+			env.ShouldNotReconcileWithError(req, MatchError(And(
+				ContainSubstring("50 installations workers exceed the maximum of 20"),
+				ContainSubstring("pickup timeout 3h0m0s exceeds the maximum of 2h0m0s"),
+			)))
+			Expect(env.Client().Get(env.Ctx, client.ObjectKeyFromObject(ls), ls)).To(Succeed())
+			Expect(ls.Status.Conditions[0].Reason).To(Equal(v1alpha2.ConditionReasonProviderConfigError))
+
+			ls.Spec.Configuration.Workers.Installations = ptr.To[int32](10)
+			ls.Spec.Configuration.DeployItemTimeouts.Pickup = &metav1.Duration{Duration: time.Hour}
+			Expect(env.Client().Update(env.Ctx, ls)).To(Succeed())
+
+			env.ShouldReconcile(req, "reconcile should create the tls route")
+			setTLSRouteAccepted(env.Ctx, tlsRoute, env.Client())
+			env.ShouldReconcile(req, "reconcile should install the landscaper instance")
+
+			configSecret := &corev1.Secret{}
+			Expect(env.Client().Get(env.Ctx, client.ObjectKey{Name: "landscaper-controller-config", Namespace: installationNamespace}, configSecret)).To(Succeed())
+			config := &lsconfig.LandscaperConfiguration{}
+			Expect(yaml.Unmarshal(configSecret.Data["config.yaml"], config)).To(Succeed())
+			Expect(config.Controllers.Installations.Workers).To(Equal(10))
+			Expect(config.Controllers.Executions.Workers).To(Equal(30))
+			Expect(config.Controllers.Contexts.Config.Default.ExcludedNamespaces).To(ConsistOf("kube-system", "tenant-system"))
+			Expect(config.DeployItemTimeouts.Pickup.Duration).To(Equal(time.Hour))
+			Expect(config.DeployItemTimeouts.Abort.Duration).To(Equal(5 * time.Minute))
+
+			mainDeployment := &appsv1.Deployment{}
+			Expect(env.Client().Get(env.Ctx, client.ObjectKey{Name: "landscaper-controller-main", Namespace: installationNamespace}, mainDeployment)).To(Succeed())
+			Expect(mainDeployment.Spec.Template.Spec.Containers[0].Args).To(ContainElement("-v=DEBUG"))
+		})
+
+		It("should configure the helm and manifest deployer", func() {
+			req := reconcile.Request{
+				NamespacedName: client.ObjectKey{
+					Name:      "test",
+					Namespace: "default",
+				},
+			}
+
+			accessRequestMCP, workloadClusterRequest, workloadAccessRequest := clusterAccessRequests(req)
+
+			ls := &v1alpha2.Landscaper{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      req.Name,
+					Namespace: req.Namespace,
+				},
+			}
+
+			identity.SetInstanceID(ls, identity.ComputeInstanceID(ls))
+			installationNamespace := identity.Instance(identity.GetInstanceID(ls)).Namespace()
+
+			tlsRoute := &gatewayv1alpha2.TLSRoute{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "webhooks-tls",
+					Namespace: installationNamespace,
+				},
+			}
+
+			env := buildTestEnvironmentReconcile("test-01", accessRequestMCP, workloadClusterRequest, workloadAccessRequest, tlsRoute)
+			grantClusterAccess(env, req, accessRequestMCP, workloadClusterRequest, workloadAccessRequest)
+
+			providerConfig := &v1alpha2.ProviderConfig{}
+			Expect(env.Client().Get(env.Ctx, client.ObjectKey{Name: "default"}, providerConfig)).To(Succeed())
+			providerConfig.Spec.ConfigurationBounds = &v1alpha2.ConfigurationBounds{
+				MaxWorkers:  ptr.To[int32](20),
+				MaxReplicas: ptr.To[int32](3),
+			}
+			Expect(env.Client().Update(env.Ctx, providerConfig)).To(Succeed())
+
+			Expect(env.Client().Get(env.Ctx, client.ObjectKeyFromObject(ls), ls)).To(Succeed())
+			ls.Spec.HelmDeployer = &v1alpha2.HelmDeployerSpec{
+				DeployerSpec: v1alpha2.DeployerSpec{
+					Configuration: &v1alpha2.DeployerConfiguration{
+						TargetSelectors: []v1alpha2.TargetSelector{
+							{
+								Targets: []v1alpha2.TargetReference{{Name: "my-cluster", Namespace: "tenant"}},
+								Labels:  []v1alpha2.SelectorRequirement{{Key: "env", Operator: "in"}},
+							},
+						},
+						DefaultExportTimeout: &metav1.Duration{Duration: 15 * time.Minute},
+						Workers:              ptr.To[int32](50),
+						MaxReplicas:          ptr.To[int32](3),
+						Verbosity:            v1alpha2.VerbosityDebug,
+					},
+				},
+			}
+			ls.Spec.ManifestDeployer = &v1alpha2.DeployerSpec{
+				Configuration: &v1alpha2.DeployerConfiguration{
+					Workers:     ptr.To[int32](10),
+					MaxReplicas: ptr.To[int32](5),
+				},
+			}
+			Expect(env.Client().Update(env.Ctx, ls)).To(Succeed())
+
+			// the workers of the helm deployer and the replicas of the manifest deployer exceed the bounds
+			env.ShouldNotReconcileWithError(req, MatchError(And(
+				ContainSubstring("50 helm deployer workers exceed the maximum of 20"),
+				ContainSubstring("5 manifest deployer replicas exceed the maximum of 3"),
+			)))
+			Expect(env.Client().Get(env.Ctx, client.ObjectKeyFromObject(ls), ls)).To(Succeed())
+			Expect(ls.Status.Conditions[0].Reason).To(Equal(v1alpha2.ConditionReasonProviderConfigError))
+
+			// the operator "in" of the label requirement has no values
+			ls.Spec.HelmDeployer.Configuration.Workers = ptr.To[int32](20)
+			ls.Spec.ManifestDeployer.Configuration.MaxReplicas = ptr.To[int32](2)
+			Expect(env.Client().Update(env.Ctx, ls)).To(Succeed())
+			env.ShouldNotReconcileWithError(req, MatchError(ContainSubstring(`target selector 0 of the helm deployer: operator "in" of key env requires at least one value`)))
+			Expect(env.Client().Get(env.Ctx, client.ObjectKeyFromObject(ls), ls)).To(Succeed())
+			Expect(ls.Status.Conditions[0].Reason).To(Equal(v1alpha2.ConditionReasonConfigurationError))
+
+			ls.Spec.HelmDeployer.Configuration.TargetSelectors[0].Labels[0].Values = []string{"dev", "test"}
+			Expect(env.Client().Update(env.Ctx, ls)).To(Succeed())
+
+			env.ShouldReconcile(req, "reconcile should create the tls route")
+			setTLSRouteAccepted(env.Ctx, tlsRoute, env.Client())
+			env.ShouldReconcile(req, "reconcile should install the landscaper instance")
+
+			configSecret := &corev1.Secret{}
+			Expect(env.Client().Get(env.Ctx, client.ObjectKey{Name: "helm-deployer-config", Namespace: installationNamespace}, configSecret)).To(Succeed())
+			helmConfig := &helmv1alpha1.Configuration{}
+			Expect(yaml.Unmarshal(configSecret.Data["config.yaml"], helmConfig)).To(Succeed())
+			Expect(helmConfig.Controller.Workers).To(Equal(20))
+			Expect(helmConfig.Export.DefaultTimeout.Duration).To(Equal(15 * time.Minute))
+			Expect(helmConfig.HPAConfiguration.MaxReplicas).To(Equal(int32(3)))
+			Expect(helmConfig.TargetSelector).To(HaveLen(1))
+			Expect(helmConfig.TargetSelector[0].Targets).To(ConsistOf(lscore.ObjectReference{Name: "my-cluster", Namespace: "tenant"}))
+			Expect(helmConfig.TargetSelector[0].Labels).To(ConsistOf(lscore.Requirement{Key: "env", Operator: selection.In, Values: []string{"dev", "test"}}))
+
+			helmDeployment := &appsv1.Deployment{}
+			Expect(env.Client().Get(env.Ctx, client.ObjectKey{Name: "helm-deployer", Namespace: installationNamespace}, helmDeployment)).To(Succeed())
+			Expect(helmDeployment.Spec.Template.Spec.Containers[0].Args).To(ContainElement("-v=debug"))
+
+			Expect(env.Client().Get(env.Ctx, client.ObjectKey{Name: "manifest-deployer-config", Namespace: installationNamespace}, configSecret)).To(Succeed())
+			manifestConfig := &manifestv1alpha2.Configuration{}
+			Expect(yaml.Unmarshal(configSecret.Data["config.yaml"], manifestConfig)).To(Succeed())
+			Expect(manifestConfig.Controller.Workers).To(Equal(10))
+			Expect(manifestConfig.HPAConfiguration.MaxReplicas).To(Equal(int32(2)))
+		})
 	})
 })
@@ -0,0 +1,66 @@
+package controller
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+
+	"github.com/openmcp-project/service-provider-landscaper/api/v1alpha2"
+	"github.com/openmcp-project/service-provider-landscaper/internal/dns"
+	"github.com/openmcp-project/service-provider-landscaper/internal/shared/types"
+)
+
+const labelNamespaceName = "kubernetes.io/metadata.name"
+
+// networkPolicyValues converts the network policy settings of the provider config into the values of the installers,
+// filling in the default rules which are not replaced. It returns nil if the network policies are disabled.
+func networkPolicyValues(providerConfig *v1alpha2.ProviderConfig) *types.NetworkPolicyValues {
+	s := providerConfig.Spec.NetworkPolicies
+	if s == nil {
+		s = &v1alpha2.NetworkPoliciesSpec{}
+	}
+	if s.Disabled {
+		return nil
+	}
+
+	v := &types.NetworkPolicyValues{
+		Gateway: s.Gateway,
+		Ingress: s.AdditionalIngress,
+	}
+	if len(v.Gateway) == 0 {
+		v.Gateway = []networkingv1.NetworkPolicyPeer{namespacePeer(dns.DefaultGatewayNamespace)}
+	}
+
+	dnsRule := networkingv1.NetworkPolicyEgressRule{
+		To: []networkingv1.NetworkPolicyPeer{{
+			NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{labelNamespaceName: metav1.NamespaceSystem}},
+			PodSelector:       &metav1.LabelSelector{MatchLabels: map[string]string{"k8s-app": "kube-dns"}},
+		}},
+		Ports: []networkingv1.NetworkPolicyPort{port(corev1.ProtocolUDP, 53), port(corev1.ProtocolTCP, 53)},
+	}
+	apiServersRule := networkingv1.NetworkPolicyEgressRule{
+		Ports: []networkingv1.NetworkPolicyPort{port(corev1.ProtocolTCP, 443), port(corev1.ProtocolTCP, 6443)},
+	}
+	registriesRule := networkingv1.NetworkPolicyEgressRule{
+		Ports: []networkingv1.NetworkPolicyPort{port(corev1.ProtocolTCP, 443)},
+	}
+	v.Egress = append(v.Egress,
+		ptr.Deref(s.DNS, dnsRule),
+		ptr.Deref(s.APIServers, apiServersRule),
+		ptr.Deref(s.Registries, registriesRule))
+	v.Egress = append(v.Egress, s.AdditionalEgress...)
+	return v
+}
+
+// namespacePeer returns a network policy peer which selects all pods of the given namespace.
+func namespacePeer(namespace string) networkingv1.NetworkPolicyPeer {
+	return networkingv1.NetworkPolicyPeer{
+		NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{labelNamespaceName: namespace}},
+	}
+}
+
+func port(protocol corev1.Protocol, port int32) networkingv1.NetworkPolicyPort {
+	return networkingv1.NetworkPolicyPort{Protocol: ptr.To(protocol), Port: ptr.To(intstr.FromInt32(port))}
+}
@@ -0,0 +1,293 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/openmcp-project/controller-utils/pkg/clusters"
+	"github.com/openmcp-project/controller-utils/pkg/logging"
+	clustersv1alpha1 "github.com/openmcp-project/openmcp-operator/api/clusters/v1alpha1"
+	openmcpconst "github.com/openmcp-project/openmcp-operator/api/constants"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	"github.com/openmcp-project/service-provider-landscaper/api/v1alpha2"
+	"github.com/openmcp-project/service-provider-landscaper/internal/shared/identity"
+)
+
+const (
+	orphanCollectorName = "OrphanCollector"
+
+	// DefaultOrphanCollectionInterval is the default interval in which orphaned resources are collected.
+	DefaultOrphanCollectionInterval = 1 * time.Hour
+)
+
+// Orphan is a resource of a Landscaper instance for which no Landscaper resource exists anymore.
+// This happens if a Landscaper resource is force-deleted, or its finalizer is removed manually.
+type Orphan struct {
+	// Cluster is the cluster on which the resource exists.
+	Cluster *clusters.Cluster
+	// Kind is the kind of the resource.
+	Kind string
+	// Instance is the Landscaper instance to which the resource belongs.
+	Instance identity.Instance
+	// Object is the orphaned resource.
+	Object client.Object
+}
+
+// OrphanCollector periodically searches the MCP and workload clusters for resources of Landscaper instances
+// that have no Landscaper resource anymore. Depending on DryRun, orphans are only reported or deleted.
+type OrphanCollector struct {
+	PlatformCluster   *clusters.Cluster
+	OnboardingCluster *clusters.Cluster
+	// ControllerName is the name with which the cluster access requests of the provider are labeled.
+	ControllerName string
+	// InstanceClusterAccess provides access to the MCP and workload clusters of a Landscaper resource.
+	// The clusters are found via the access requests, which are left behind together with the orphans.
+	InstanceClusterAccess InstanceClusterAccess
+	// Interval is the interval in which orphans are collected.
+	Interval time.Duration
+	// DryRun reports orphans without deleting them.
+	DryRun bool
+}
+
+// Start collects orphans periodically until the context is cancelled. It implements manager.Runnable.
+func (c *OrphanCollector) Start(ctx context.Context) error {
+	log := logging.Wrap(ctrl.Log).WithName(orphanCollectorName)
+	ctx = logging.NewContext(ctx, log)
+
+	wait.UntilWithContext(ctx, func(ctx context.Context) {
+		if _, err := c.Collect(ctx); err != nil {
+			log.Error(err, "failed to collect orphaned resources")
+		}
+	}, c.Interval)
+
+	return nil
+}
+
+// collectedClusters are the clusters searched for orphans, deduplicated by their API server endpoint,
+// because several Landscaper instances may share a workload cluster.
+type collectedClusters struct {
+	mcp      []*clusters.Cluster
+	workload []*clusters.Cluster
+	seen     sets.Set[string]
+}
+
+func (cc *collectedClusters) add(target *[]*clusters.Cluster, cluster *clusters.Cluster) {
+	key := cluster.APIServerEndpoint()
+	if key == "" {
+		key = cluster.ID()
+	}
+	if cc.seen.Has(key) {
+		return
+	}
+	cc.seen.Insert(key)
+	*target = append(*target, cluster)
+}
+
+// Collect searches for orphans once. Unless DryRun is set, the orphans are deleted.
+// It returns the orphans that have been found.
+func (c *OrphanCollector) Collect(ctx context.Context) ([]Orphan, error) {
+	log := logging.FromContextOrPanic(ctx)
+
+	targets, err := c.clusters(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// The candidates must be listed before the Landscaper resources. A Landscaper resource is always created
+	// before its resources, so a candidate whose Landscaper resource exists is never mistaken for an orphan.
+	candidates := []Orphan{}
+	for _, cluster := range targets.workload {
+		found, err := listCandidates(ctx, cluster, true)
+		if err != nil {
+			return nil, err
+		}
+		candidates = append(candidates, found...)
+	}
+	for _, cluster := range targets.mcp {
+		found, err := listCandidates(ctx, cluster, false)
+		if err != nil {
+			return nil, err
+		}
+		candidates = append(candidates, found...)
+	}
+
+	instances, err := c.existingInstances(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	orphans := []Orphan{}
+	for _, candidate := range candidates {
+		if !instances.Has(candidate.Instance) {
+			orphans = append(orphans, candidate)
+		}
+	}
+
+	for _, orphan := range orphans {
+		keysAndValues := []any{
+			"cluster", orphan.Cluster.ID(),
+			"instance", orphan.Instance,
+			"kind", orphan.Kind,
+			"name", orphan.Object.GetName(),
+			"namespace", orphan.Object.GetNamespace(),
+		}
+
+		if c.DryRun {
+			log.Info("Found orphaned resource (dry run)", keysAndValues...)
+			continue
+		}
+
+		if err := client.IgnoreNotFound(orphan.Cluster.Client().Delete(ctx, orphan.Object)); err != nil {
+			log.Error(err, "Failed to delete orphaned resource", keysAndValues...)
+			continue
+		}
+		log.Info("Deleted orphaned resource", keysAndValues...)
+	}
+
+	return orphans, nil
+}
+
+// clusters returns the MCP and workload clusters for which the provider holds access requests.
+func (c *OrphanCollector) clusters(ctx context.Context) (*collectedClusters, error) {
+	log := logging.FromContextOrPanic(ctx)
+
+	accessRequests := &clustersv1alpha1.AccessRequestList{}
+	if err := c.PlatformCluster.Client().List(ctx, accessRequests, client.MatchingLabels{openmcpconst.ManagedByLabel: c.ControllerName}); err != nil {
+		return nil, fmt.Errorf("failed to list access requests: %w", err)
+	}
+
+	requests := sets.New[reconcile.Request]()
+	for _, accessRequest := range accessRequests.Items {
+		name := accessRequest.Labels[openmcpconst.OnboardingNameLabel]
+		namespace := accessRequest.Labels[openmcpconst.OnboardingNamespaceLabel]
+		if name == "" || namespace == "" {
+			continue
+		}
+		requests.Insert(reconcile.Request{NamespacedName: client.ObjectKey{Name: name, Namespace: namespace}})
+	}
+
+	targets := &collectedClusters{seen: sets.New[string]()}
+	for _, req := range requests.UnsortedList() {
+		if workloadCluster, err := c.InstanceClusterAccess.WorkloadCluster(ctx, req); err != nil {
+			log.Debug("Workload cluster not accessible", "landscaper", req.Name, "namespace", req.Namespace, "error", err.Error())
+		} else {
+			targets.add(&targets.workload, workloadCluster)
+		}
+
+		if mcpCluster, err := c.InstanceClusterAccess.MCPCluster(ctx, req); err != nil {
+			log.Debug("MCP cluster not accessible", "landscaper", req.Name, "namespace", req.Namespace, "error", err.Error())
+		} else {
+			targets.add(&targets.mcp, mcpCluster)
+		}
+	}
+
+	return targets, nil
+}
+
+// existingInstances returns the instances of all Landscaper resources on the onboarding cluster.
+func (c *OrphanCollector) existingInstances(ctx context.Context) (sets.Set[identity.Instance], error) {
+	landscapers := &v1alpha2.LandscaperList{}
+	if err := c.OnboardingCluster.Client().List(ctx, landscapers); err != nil {
+		return nil, fmt.Errorf("failed to list Landscaper resources: %w", err)
+	}
+
+	instances := sets.New[identity.Instance]()
+	for _, ls := range landscapers.Items {
+		// the computed instance id covers Landscaper resources whose id label has not yet been set
+		instances.Insert(identity.Instance(identity.ComputeInstanceID(&ls)))
+		if id := identity.GetInstanceID(&ls); id != "" {
+			instances.Insert(identity.Instance(id))
+		}
+	}
+	return instances, nil
+}
+
+// listCandidates lists the resources on a cluster that belong to a Landscaper instance: instance namespaces,
+// webhooks TLSRoutes in these namespaces (on workload clusters only), ClusterRoles and ClusterRoleBindings
+// (on MCP clusters only), and secrets.
+// Only resources labeled as managed by the provider, or living in such a namespace, are candidates.
+// The MCP clusters belong to the tenants, whose resources may have names like those of an instance.
+func listCandidates(ctx context.Context, cluster *clusters.Cluster, workload bool) ([]Orphan, error) {
+	candidates := []Orphan{}
+	add := func(kind string, instance identity.Instance, obj client.Object) {
+		candidates = append(candidates, Orphan{Cluster: cluster, Kind: kind, Instance: instance, Object: obj})
+	}
+
+	namespaces := &corev1.NamespaceList{}
+	if err := cluster.Client().List(ctx, namespaces, client.MatchingLabels(identity.ManagedByLabels())); err != nil {
+		return nil, fmt.Errorf("failed to list namespaces on cluster %s: %w", cluster.ID(), err)
+	}
+	instanceNamespaces := sets.New[string]()
+	for _, namespace := range namespaces.Items {
+		if _, ok := identity.InstanceFromNamespace(namespace.Name); ok {
+			instanceNamespaces.Insert(namespace.Name)
+		}
+	}
+
+	if workload {
+		tlsRoutes := &gatewayv1alpha2.TLSRouteList{}
+		if err := cluster.Client().List(ctx, tlsRoutes); err != nil {
+			return nil, fmt.Errorf("failed to list TLSRoutes on cluster %s: %w", cluster.ID(), err)
+		}
+		for i := range tlsRoutes.Items {
+			tlsRoute := &tlsRoutes.Items[i]
+			if !instanceNamespaces.Has(tlsRoute.Namespace) || tlsRoute.Name != dnsServiceName() {
+				continue
+			}
+			if instance, ok := identity.InstanceFromNamespace(tlsRoute.Namespace); ok {
+				add("TLSRoute", instance, tlsRoute)
+			}
+		}
+	}
+
+	secrets := &corev1.SecretList{}
+	if err := cluster.Client().List(ctx, secrets, client.MatchingLabels(identity.ManagedByLabels())); err != nil {
+		return nil, fmt.Errorf("failed to list secrets on cluster %s: %w", cluster.ID(), err)
+	}
+	for i := range secrets.Items {
+		if instance, ok := identity.InstanceFromLabels(secrets.Items[i].Labels); ok {
+			add("Secret", instance, &secrets.Items[i])
+		}
+	}
+
+	// the cluster roles and bindings of the components only exist on the MCP clusters
+	if !workload {
+		clusterRoles := &rbacv1.ClusterRoleList{}
+		if err := cluster.Client().List(ctx, clusterRoles, client.MatchingLabels(identity.ManagedByLabels())); err != nil {
+			return nil, fmt.Errorf("failed to list ClusterRoles on cluster %s: %w", cluster.ID(), err)
+		}
+		for i := range clusterRoles.Items {
+			if instance, ok := identity.InstanceFromClusterScopedResourceName(clusterRoles.Items[i].Name); ok {
+				add("ClusterRole", instance, &clusterRoles.Items[i])
+			}
+		}
+
+		clusterRoleBindings := &rbacv1.ClusterRoleBindingList{}
+		if err := cluster.Client().List(ctx, clusterRoleBindings, client.MatchingLabels(identity.ManagedByLabels())); err != nil {
+			return nil, fmt.Errorf("failed to list ClusterRoleBindings on cluster %s: %w", cluster.ID(), err)
+		}
+		for i := range clusterRoleBindings.Items {
+			if instance, ok := identity.InstanceFromClusterScopedResourceName(clusterRoleBindings.Items[i].Name); ok {
+				add("ClusterRoleBinding", instance, &clusterRoleBindings.Items[i])
+			}
+		}
+	}
+
+	// namespaces come last, so that the resources inside are deleted first
+	for i := range namespaces.Items {
+		if instance, ok := identity.InstanceFromNamespace(namespaces.Items[i].Name); ok {
+			add("Namespace", instance, &namespaces.Items[i])
+		}
+	}
+
+	return candidates, nil
+}
@@ -0,0 +1,216 @@
+package controller_test
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/openmcp-project/controller-utils/pkg/clusters"
+	testutils "github.com/openmcp-project/controller-utils/pkg/testing"
+	clustersv1alpha1 "github.com/openmcp-project/openmcp-operator/api/clusters/v1alpha1"
+	openmcpconst "github.com/openmcp-project/openmcp-operator/api/constants"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/openmcp-project/service-provider-landscaper/api/v1alpha2"
+	lscontroller "github.com/openmcp-project/service-provider-landscaper/internal/controller"
+	"github.com/openmcp-project/service-provider-landscaper/internal/shared/identity"
+)
+
+const (
+	platform    = "platform"
+	workload    = "workload"
+	mcpExisting = "mcp-existing"
+	mcpDeleted  = "mcp-deleted"
+)
+
+// instanceObjects returns the resources of an instance on the workload and the mcp cluster.
+func instanceObjects(instance identity.Instance) (workloadObjects, mcpObjects []client.Object) {
+	component := identity.NewComponent(instance, "v0.135.0", "landscaper-controller")
+	namespace := instance.Namespace()
+
+	workloadObjects = []client.Object{
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: namespace, Labels: instance.Labels()}},
+		&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "config", Namespace: namespace, Labels: component.Labels()}},
+		&gatewayv1alpha2.TLSRoute{ObjectMeta: metav1.ObjectMeta{Name: "webhooks-tls", Namespace: namespace}},
+	}
+	mcpObjects = []client.Object{
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: namespace, Labels: instance.Labels()}},
+		&rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: component.ClusterScopedResourceName("user"), Labels: component.Labels()}},
+		&rbacv1.ClusterRoleBinding{ObjectMeta: metav1.ObjectMeta{Name: component.ClusterScopedDefaultResourceName(), Labels: component.Labels()}},
+	}
+	return workloadObjects, mcpObjects
+}
+
+// lookAlikeObjects returns resources of others whose names look like those of an instance, but which are not labeled
+// as managed by the provider.
+func lookAlikeObjects() []client.Object {
+	return []client.Object{
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "ls-system-tenant"}},
+		&gatewayv1alpha2.TLSRoute{ObjectMeta: metav1.ObjectMeta{Name: "webhooks-tls", Namespace: "ls-system-tenant"}},
+		&rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: "landscaper:tenant:admin"}},
+		&rbacv1.ClusterRoleBinding{ObjectMeta: metav1.ObjectMeta{Name: "landscaper:tenant:admin"}},
+	}
+}
+
+func accessRequest(name, landscaperName string) *clustersv1alpha1.AccessRequest {
+	return &clustersv1alpha1.AccessRequest{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "mcp--default",
+			Labels: map[string]string{
+				openmcpconst.ManagedByLabel:           controllerName,
+				openmcpconst.OnboardingNameLabel:      landscaperName,
+				openmcpconst.OnboardingNamespaceLabel: "default",
+			},
+		},
+	}
+}
+
+// perInstanceClusterAccess provides a separate MCP cluster for each Landscaper resource, and a shared workload cluster.
+type perInstanceClusterAccess struct {
+	mcpClusters     map[string]*clusters.Cluster
+	workloadCluster *clusters.Cluster
+}
+
+func (a *perInstanceClusterAccess) MCPCluster(_ context.Context, req reconcile.Request) (*clusters.Cluster, error) {
+	if cluster, ok := a.mcpClusters[req.Name]; ok {
+		return cluster, nil
+	}
+	return nil, fmt.Errorf("no mcp cluster for landscaper %s", req.Name)
+}
+
+func (a *perInstanceClusterAccess) WorkloadCluster(_ context.Context, _ reconcile.Request) (*clusters.Cluster, error) {
+	return a.workloadCluster, nil
+}
+
+var _ = Describe("Orphan Collector", func() {
+	var (
+		env       *testutils.ComplexEnvironment
+		collector *lscontroller.OrphanCollector
+
+		existingWorkloadObjects, existingMCPObjects []client.Object
+		orphanWorkloadObjects, orphanMCPObjects     []client.Object
+		unrelatedObjects                            []client.Object
+		lookAlikes                                  []client.Object
+	)
+
+	BeforeEach(func() {
+		scheme := runtime.NewScheme()
+		utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+		utilruntime.Must(clustersv1alpha1.AddToScheme(scheme))
+		utilruntime.Must(v1alpha2.AddToScheme(scheme))
+		utilruntime.Must(gatewayv1.Install(scheme))
+		utilruntime.Must(gatewayv1alpha2.Install(scheme))
+
+		existing := &v1alpha2.Landscaper{ObjectMeta: metav1.ObjectMeta{Name: "existing", Namespace: "default"}}
+		identity.SetInstanceID(existing, identity.ComputeInstanceID(existing))
+		existingWorkloadObjects, existingMCPObjects = instanceObjects(identity.Instance(identity.GetInstanceID(existing)))
+
+		deleted := &v1alpha2.Landscaper{ObjectMeta: metav1.ObjectMeta{Name: "deleted", Namespace: "default"}}
+		orphanWorkloadObjects, orphanMCPObjects = instanceObjects(identity.Instance(identity.ComputeInstanceID(deleted)))
+
+		unrelatedObjects = []client.Object{
+			&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "kube-system"}},
+			&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "unrelated", Namespace: "kube-system"}},
+			&rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: "cluster-admin"}},
+		}
+		lookAlikes = lookAlikeObjects()
+
+		env = testutils.NewComplexEnvironmentBuilder().
+			WithFakeClient(platform, scheme).
+			WithInitObjects(platform, existing, accessRequest("existing-mcp", "existing"), accessRequest("deleted-mcp", "deleted")).
+			WithFakeClient(workload, scheme).
+			WithInitObjects(workload, slices.Concat(existingWorkloadObjects, orphanWorkloadObjects, unrelatedObjects, lookAlikeObjects())...).
+			WithFakeClient(mcpExisting, scheme).
+			WithInitObjects(mcpExisting, slices.Concat(existingMCPObjects, lookAlikes)...).
+			WithFakeClient(mcpDeleted, scheme).
+			WithInitObjects(mcpDeleted, slices.Concat(orphanMCPObjects, lookAlikeObjects())...).
+			Build()
+
+		platformCluster := clusters.NewTestClusterFromClient(platform, env.Client(platform))
+		collector = &lscontroller.OrphanCollector{
+			PlatformCluster:   platformCluster,
+			OnboardingCluster: platformCluster,
+			ControllerName:    controllerName,
+			InstanceClusterAccess: &perInstanceClusterAccess{
+				mcpClusters: map[string]*clusters.Cluster{
+					"existing": clusters.NewTestClusterFromClient(mcpExisting, env.Client(mcpExisting)),
+					"deleted":  clusters.NewTestClusterFromClient(mcpDeleted, env.Client(mcpDeleted)),
+				},
+				workloadCluster: clusters.NewTestClusterFromClient(workload, env.Client(workload)),
+			},
+		}
+	})
+
+	expectExists := func(clusterName string, objects []client.Object, exists bool) {
+		for _, obj := range objects {
+			err := env.Client(clusterName).Get(env.Ctx, client.ObjectKeyFromObject(obj), obj)
+			if exists {
+				Expect(err).ToNot(HaveOccurred(), "%T %s should exist", obj, obj.GetName())
+			} else {
+				Expect(apierrors.IsNotFound(err)).To(BeTrue(), "%T %s should be deleted", obj, obj.GetName())
+			}
+		}
+	}
+
+	orphanNames := func(orphans []lscontroller.Orphan) []string {
+		names := []string{}
+		for _, orphan := range orphans {
+			names = append(names, orphan.Cluster.ID()+"/"+orphan.Kind+"/"+orphan.Object.GetName())
+		}
+		return names
+	}
+
+	It("should only report orphans in dry-run mode", func() {
+		collector.DryRun = true
+
+		orphans, err := collector.Collect(env.Ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(orphanNames(orphans)).To(ConsistOf(
+			"workload/Namespace/"+orphanWorkloadObjects[0].GetName(),
+			"workload/Secret/config",
+			"workload/TLSRoute/webhooks-tls",
+			"mcp-deleted/Namespace/"+orphanMCPObjects[0].GetName(),
+			"mcp-deleted/ClusterRole/"+orphanMCPObjects[1].GetName(),
+			"mcp-deleted/ClusterRoleBinding/"+orphanMCPObjects[2].GetName(),
+		))
+
+		expectExists(workload, orphanWorkloadObjects, true)
+		expectExists(mcpDeleted, orphanMCPObjects, true)
+	})
+
+	It("should delete orphans", func() {
+		orphans, err := collector.Collect(env.Ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(orphans).To(HaveLen(6))
+
+		expectExists(workload, orphanWorkloadObjects, false)
+		expectExists(mcpDeleted, orphanMCPObjects, false)
+		expectExists(workload, existingWorkloadObjects, true)
+		expectExists(mcpExisting, existingMCPObjects, true)
+		expectExists(workload, unrelatedObjects, true)
+
+		// resources of others with names like those of an instance are not touched
+		expectExists(workload, lookAlikeObjects(), true)
+		expectExists(mcpExisting, lookAlikes, true)
+		expectExists(mcpDeleted, lookAlikeObjects(), true)
+
+		// nothing is left to collect
+		orphans, err = collector.Collect(env.Ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(orphans).To(BeEmpty())
+	})
+})
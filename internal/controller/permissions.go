@@ -0,0 +1,207 @@
+package controller
+
+import (
+	clustersv1alpha1 "github.com/openmcp-project/openmcp-operator/api/clusters/v1alpha1"
+	rbac "k8s.io/api/rbac/v1"
+
+	"github.com/openmcp-project/service-provider-landscaper/api/v1alpha2"
+	"github.com/openmcp-project/service-provider-landscaper/internal/dns"
+)
+
+var (
+	defaultVerbs = []string{"get", "list", "watch", "create", "update", "patch", "delete"}
+	readVerbs    = []string{"get", "list", "watch"}
+	eventVerbs   = []string{"create", "update", "patch"}
+)
+
+// MCPPermissions are the permissions of the provider on the MCP clusters.
+// The provider deploys the Landscaper CRDs and grants the components of an instance their own permissions,
+// so it needs every permission it grants to them.
+func MCPPermissions() []clustersv1alpha1.PermissionsRequest {
+	return []clustersv1alpha1.PermissionsRequest{
+		{
+			Rules: []rbac.PolicyRule{
+				{
+					APIGroups: []string{"apiextensions.k8s.io"},
+					Resources: []string{"customresourcedefinitions"},
+					Verbs:     defaultVerbs,
+				},
+				{
+					APIGroups: []string{"landscaper.gardener.cloud"},
+					Resources: []string{"*"},
+					Verbs:     defaultVerbs,
+				},
+				{
+					APIGroups: []string{""},
+					Resources: []string{"secrets", "configmaps"},
+					Verbs:     defaultVerbs,
+				},
+				{
+					APIGroups: []string{""},
+					Resources: []string{"serviceaccounts"},
+					Verbs:     defaultVerbs,
+				},
+				{
+					APIGroups: []string{""},
+					Resources: []string{"serviceaccounts/token"},
+					Verbs:     []string{"create"},
+				},
+				{
+					APIGroups: []string{""},
+					Resources: []string{"namespaces"},
+					Verbs:     defaultVerbs,
+				},
+				{
+					APIGroups: []string{"rbac.authorization.k8s.io"},
+					Resources: []string{"clusterroles", "clusterrolebindings", "roles", "rolebindings"},
+					Verbs:     defaultVerbs,
+				},
+				{
+					APIGroups: []string{""},
+					Resources: []string{"events"},
+					Verbs:     defaultVerbs,
+				},
+				{
+					APIGroups: []string{"admissionregistration.k8s.io"},
+					Resources: []string{"validatingwebhookconfigurations"},
+					Verbs:     defaultVerbs,
+				},
+			},
+		},
+	}
+}
+
+// WorkloadPermissions are the permissions of the provider on the workload clusters. The list is reviewed by hand:
+// every kind that the installers create must be covered, which is verified by a test.
+//
+// The resources of an instance live in its namespace ls-system-<id>. RBAC cannot restrict rules to namespaces
+// with a common prefix, therefore the namespaced resources are requested cluster-wide.
+// Only the default gateway lives in a fixed namespace, so that the rule for it is restricted to this namespace.
+func WorkloadPermissions() []clustersv1alpha1.PermissionsRequest {
+	return []clustersv1alpha1.PermissionsRequest{
+		{
+			Rules: []rbac.PolicyRule{
+				{
+					APIGroups: []string{""},
+					Resources: []string{"namespaces"},
+					Verbs:     defaultVerbs,
+				},
+				{
+					APIGroups: []string{""},
+					Resources: []string{"secrets", "configmaps", "services", "persistentvolumeclaims", "resourcequotas", "limitranges"},
+					Verbs:     defaultVerbs,
+				},
+				{
+					APIGroups: []string{"apps"},
+					Resources: []string{"deployments"},
+					Verbs:     defaultVerbs,
+				},
+				{
+					APIGroups: []string{"autoscaling"},
+					Resources: []string{"horizontalpodautoscalers"},
+					Verbs:     defaultVerbs,
+				},
+				{
+					APIGroups: []string{"autoscaling.k8s.io"},
+					Resources: []string{"verticalpodautoscalers"},
+					Verbs:     defaultVerbs,
+				},
+				{
+					APIGroups: []string{"policy"},
+					Resources: []string{"poddisruptionbudgets"},
+					Verbs:     defaultVerbs,
+				},
+				{
+					APIGroups: []string{"networking.k8s.io"},
+					Resources: []string{"networkpolicies"},
+					Verbs:     defaultVerbs,
+				},
+				{
+					APIGroups: []string{"gateway.networking.k8s.io"},
+					Resources: []string{"tlsroutes"},
+					Verbs:     defaultVerbs,
+				},
+				// The container deployer uses the kubeconfig of the provider to run the pods of its deploy items.
+				// It creates service accounts for them with roles to write their state into secrets.
+				{
+					APIGroups: []string{""},
+					Resources: []string{"pods", "serviceaccounts"},
+					Verbs:     defaultVerbs,
+				},
+				{
+					APIGroups: []string{"rbac.authorization.k8s.io"},
+					Resources: []string{"roles", "rolebindings"},
+					Verbs:     defaultVerbs,
+				},
+				{
+					APIGroups: []string{""},
+					Resources: []string{"events"},
+					Verbs:     eventVerbs,
+				},
+			},
+		},
+		{
+			Namespace:                         dns.DefaultGatewayNamespace,
+			DisableAutomaticNamespaceCreation: true,
+			Rules: []rbac.PolicyRule{
+				{
+					APIGroups: []string{"gateway.networking.k8s.io"},
+					Resources: []string{"gateways"},
+					Verbs:     readVerbs,
+				},
+			},
+		},
+	}
+}
+
+// OnboardingPermissions are the permissions of the provider on the onboarding cluster.
+// The provider reconciles the Landscaper resources and reads the registry secrets which they reference,
+// as well as the image pull secrets and the CA bundle config map which the ProviderConfig references with source Onboarding.
+// The referenced secrets and config maps are read with an uncached client. Only the metadata of the secrets with the
+// watch label are watched, so that a change of these secrets triggers the reconciliation right away.
+// In its own namespace, it holds the lease for the leader election.
+func OnboardingPermissions(providerSystemNamespace string) []clustersv1alpha1.PermissionsRequest {
+	return []clustersv1alpha1.PermissionsRequest{
+		{
+			Rules: []rbac.PolicyRule{
+				{
+					APIGroups: []string{v1alpha2.GroupVersion.Group},
+					Resources: []string{"landscapers"},
+					Verbs:     []string{"get", "list", "watch", "update", "patch"},
+				},
+				{
+					APIGroups: []string{v1alpha2.GroupVersion.Group},
+					Resources: []string{"landscapers/status", "landscapers/finalizers"},
+					Verbs:     []string{"get", "update", "patch"},
+				},
+				// The secrets are listed and watched for the secrets with the watch label. As a label selector
+				// cannot be expressed in a role, the permissions cannot be restricted to these secrets.
+				{
+					APIGroups: []string{""},
+					Resources: []string{"secrets"},
+					Verbs:     []string{"get", "list", "watch"},
+				},
+				{
+					APIGroups: []string{""},
+					Resources: []string{"configmaps"},
+					Verbs:     []string{"get"},
+				},
+			},
+		},
+		{
+			Namespace: providerSystemNamespace,
+			Rules: []rbac.PolicyRule{
+				{
+					APIGroups: []string{"coordination.k8s.io"},
+					Resources: []string{"leases"},
+					Verbs:     []string{"get", "list", "watch", "create", "update", "patch", "delete"},
+				},
+				{
+					APIGroups: []string{""},
+					Resources: []string{"events"},
+					Verbs:     []string{"create", "update", "patch"},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,344 @@
+package controller_test
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	clustersv1alpha1 "github.com/openmcp-project/openmcp-operator/api/clusters/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	"github.com/openmcp-project/service-provider-landscaper/api/v1alpha2"
+	lscontroller "github.com/openmcp-project/service-provider-landscaper/internal/controller"
+	"github.com/openmcp-project/service-provider-landscaper/internal/shared/identity"
+)
+
+// apiRequest is a request of the provider to the api server of a cluster.
+type apiRequest struct {
+	group     string
+	resource  string
+	namespace string
+	verb      string
+}
+
+func (r apiRequest) String() string {
+	return fmt.Sprintf("%s %s.%s in namespace %q", r.verb, r.resource, r.group, r.namespace)
+}
+
+// apiRecorder records the requests which are sent with a client.
+type apiRecorder struct {
+	scheme   *runtime.Scheme
+	requests []apiRequest
+}
+
+func (a *apiRecorder) record(obj runtime.Object, namespace, subResource, verb string) {
+	gvk, err := apiutil.GVKForObject(obj, a.scheme)
+	Expect(err).ToNot(HaveOccurred())
+	r := apiRequest{group: gvk.Group, resource: resourceName(strings.TrimSuffix(gvk.Kind, "List")), namespace: namespace, verb: verb}
+	if subResource != "" {
+		r.resource += "/" + subResource
+	}
+	a.requests = append(a.requests, r)
+}
+
+// resourceName returns the resource name of a kind. Unlike meta.UnsafeGuessKindToResource,
+// it does not turn a trailing y after a vowel into ies, as in gateways.
+func resourceName(kind string) string {
+	name := strings.ToLower(kind)
+	switch {
+	case strings.HasSuffix(name, "s"):
+		return name + "es"
+	case strings.HasSuffix(name, "y") && !strings.ContainsAny(name[len(name)-2:len(name)-1], "aeiou"):
+		return strings.TrimSuffix(name, "y") + "ies"
+	default:
+		return name + "s"
+	}
+}
+
+// wrap returns a client which records all requests and passes them on to the given client.
+func (a *apiRecorder) wrap(c client.WithWatch) client.WithWatch {
+	a.scheme = c.Scheme()
+	return interceptor.NewClient(c, interceptor.Funcs{
+		Get: func(ctx context.Context, c client.WithWatch, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+			a.record(obj, key.Namespace, "", "get")
+			return c.Get(ctx, key, obj, opts...)
+		},
+		List: func(ctx context.Context, c client.WithWatch, list client.ObjectList, opts ...client.ListOption) error {
+			a.record(list, (&client.ListOptions{}).ApplyOptions(opts).Namespace, "", "list")
+			return c.List(ctx, list, opts...)
+		},
+		Create: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+			a.record(obj, obj.GetNamespace(), "", "create")
+			return c.Create(ctx, obj, opts...)
+		},
+		Update: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.UpdateOption) error {
+			a.record(obj, obj.GetNamespace(), "", "update")
+			return c.Update(ctx, obj, opts...)
+		},
+		Patch: func(ctx context.Context, c client.WithWatch, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+			a.record(obj, obj.GetNamespace(), "", "patch")
+			return c.Patch(ctx, obj, patch, opts...)
+		},
+		Delete: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.DeleteOption) error {
+			a.record(obj, obj.GetNamespace(), "", "delete")
+			return c.Delete(ctx, obj, opts...)
+		},
+		DeleteAllOf: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.DeleteAllOfOption) error {
+			a.record(obj, (&client.DeleteAllOfOptions{}).ApplyOptions(opts).Namespace, "", "deletecollection")
+			return c.DeleteAllOf(ctx, obj, opts...)
+		},
+		SubResourceGet: func(ctx context.Context, c client.Client, subResourceName string, obj, subResource client.Object, opts ...client.SubResourceGetOption) error {
+			a.record(obj, obj.GetNamespace(), subResourceName, "get")
+			return c.SubResource(subResourceName).Get(ctx, obj, subResource, opts...)
+		},
+		SubResourceCreate: func(ctx context.Context, c client.Client, subResourceName string, obj, subResource client.Object, opts ...client.SubResourceCreateOption) error {
+			a.record(obj, obj.GetNamespace(), subResourceName, "create")
+			return c.SubResource(subResourceName).Create(ctx, obj, subResource, opts...)
+		},
+		SubResourceUpdate: func(ctx context.Context, c client.Client, subResourceName string, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+			a.record(obj, obj.GetNamespace(), subResourceName, "update")
+			return c.SubResource(subResourceName).Update(ctx, obj, opts...)
+		},
+		SubResourcePatch: func(ctx context.Context, c client.Client, subResourceName string, obj client.Object, patch client.Patch, opts ...client.SubResourcePatchOption) error {
+			a.record(obj, obj.GetNamespace(), subResourceName, "patch")
+			return c.SubResource(subResourceName).Patch(ctx, obj, patch, opts...)
+		},
+	})
+}
+
+// isPermitted returns true if one of the permission requests allows the api request.
+// Permissions with a namespace only apply to requests in that namespace.
+func isPermitted(permissions []clustersv1alpha1.PermissionsRequest, r apiRequest) bool {
+	for _, p := range permissions {
+		if p.Namespace != "" && p.Namespace != r.namespace {
+			continue
+		}
+		for _, rule := range p.Rules {
+			if slices.Contains(rule.APIGroups, r.group) && slices.Contains(rule.Resources, r.resource) && slices.Contains(rule.Verbs, r.verb) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+var _ = Describe("Permissions", func() {
+	It("should not request wildcard permissions on the workload cluster", func() {
+		for _, p := range lscontroller.WorkloadPermissions() {
+			for _, rule := range p.Rules {
+				Expect(rule.APIGroups).ToNot(ContainElement("*"))
+				Expect(rule.Resources).ToNot(ContainElement("*"))
+				Expect(rule.Verbs).ToNot(ContainElement("*"))
+			}
+		}
+	})
+
+	It("should cover all requests of the provider to the workload cluster", func() {
+		req := reconcile.Request{
+			NamespacedName: client.ObjectKey{
+				Name:      "test",
+				Namespace: "default",
+			},
+		}
+
+		accessRequestMCP, workloadClusterRequest, workloadAccessRequest := clusterAccessRequests(req)
+
+		ls := &v1alpha2.Landscaper{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      req.Name,
+				Namespace: req.Namespace,
+			},
+		}
+		identity.SetInstanceID(ls, identity.ComputeInstanceID(ls))
+		installationNamespace := identity.Instance(identity.GetInstanceID(ls)).Namespace()
+
+		tlsRoute := &gatewayv1alpha2.TLSRoute{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "webhooks-tls",
+				Namespace: installationNamespace,
+			},
+		}
+
+		recorder := &apiRecorder{}
+		env := buildTestEnvironmentReconcileWithWorkloadClient("test-01", recorder.wrap,
+			accessRequestMCP, workloadClusterRequest, workloadAccessRequest, tlsRoute)
+		grantClusterAccess(env, req, accessRequestMCP, workloadClusterRequest, workloadAccessRequest)
+
+		// enable all features which create resources on the workload cluster
+		providerConfig := &v1alpha2.ProviderConfig{}
+		Expect(env.Client().Get(env.Ctx, client.ObjectKey{Name: "default"}, providerConfig)).To(Succeed())
+		providerConfig.Spec.Deployment.Deployers = []v1alpha2.DeployerOffering{
+			{Name: v1alpha2.DeployerContainer},
+			{Name: v1alpha2.DeployerMock},
+			{Name: "custom", Image: &v1alpha2.ImageConfiguration{Image: "registry.test/custom-deployer:v1.0.0"}},
+		}
+		providerConfig.Spec.NetworkPolicies = &v1alpha2.NetworkPoliciesSpec{}
+		providerConfig.Spec.ResourceQuota = &v1alpha2.ResourceQuotaSpec{}
+		providerConfig.Spec.VerticalScaling = &v1alpha2.VerticalScalingSpec{}
+		providerConfig.Spec.HorizontalScaling = &v1alpha2.HorizontalScalingSpec{
+			HelmDeployer: &v1alpha2.HorizontalPodAutoscalerSpec{MinReplicas: ptr.To[int32](2)},
+		}
+		Expect(env.Client().Update(env.Ctx, providerConfig)).To(Succeed())
+
+		Expect(env.Client().Get(env.Ctx, client.ObjectKeyFromObject(ls), ls)).To(Succeed())
+		ls.Spec.Deployers = []string{v1alpha2.DeployerContainer, v1alpha2.DeployerMock, "custom"}
+		Expect(env.Client().Update(env.Ctx, ls)).To(Succeed())
+
+		env.ShouldReconcile(req, "reconcile should create the tls route")
+		setTLSRouteAccepted(env.Ctx, tlsRoute, env.Client())
+		env.ShouldReconcile(req, "reconcile should install the landscaper instance")
+
+		Expect(env.Client().Get(env.Ctx, client.ObjectKeyFromObject(ls), ls)).To(Succeed())
+		Expect(ls.Status.Conditions[0].Type).To(Equal(v1alpha2.ConditionTypeInstalled))
+		Expect(ls.Status.Conditions[0].Status).To(Equal(metav1.ConditionTrue))
+
+		// deselect a deployer, and delete the instance
+		ls.Spec.Deployers = []string{v1alpha2.DeployerContainer, "custom"}
+		Expect(env.Client().Update(env.Ctx, ls)).To(Succeed())
+		env.ShouldReconcile(req, "reconcile should uninstall the mock deployer")
+
+		Expect(env.Client().Delete(env.Ctx, ls)).To(Succeed())
+		Eventually(func(g Gomega) {
+			_ = env.ShouldReconcile(req, "should reconcile after deletion")
+			g.Expect(env.Client().Get(env.Ctx, client.ObjectKeyFromObject(ls), ls)).ToNot(Succeed())
+		}, 10*time.Second, 1*time.Second).Should(Succeed())
+
+		Expect(recorder.requests).ToNot(BeEmpty())
+		permissions := lscontroller.WorkloadPermissions()
+		notPermitted := []string{}
+		for _, r := range recorder.requests {
+			if !isPermitted(permissions, r) {
+				notPermitted = append(notPermitted, r.String())
+			}
+		}
+		Expect(notPermitted).To(BeEmpty(), "the workload permissions must allow all requests of the provider")
+	})
+
+	It("should not request wildcard permissions on the onboarding cluster", func() {
+		for _, p := range lscontroller.OnboardingPermissions("openmcp-system") {
+			for _, rule := range p.Rules {
+				Expect(rule.APIGroups).ToNot(ContainElement("*"))
+				Expect(rule.Resources).ToNot(ContainElement("*"))
+				Expect(rule.Verbs).ToNot(ContainElement("*"))
+			}
+		}
+	})
+
+	It("should restrict the leases and events on the onboarding cluster to the namespace of the provider", func() {
+		permissions := lscontroller.OnboardingPermissions("openmcp-system")
+		Expect(isPermitted(permissions, apiRequest{group: "coordination.k8s.io", resource: "leases", namespace: "openmcp-system", verb: "update"})).To(BeTrue())
+		Expect(isPermitted(permissions, apiRequest{group: "coordination.k8s.io", resource: "leases", namespace: "tenant", verb: "update"})).To(BeFalse())
+		Expect(isPermitted(permissions, apiRequest{group: "", resource: "events", namespace: "tenant", verb: "create"})).To(BeFalse())
+	})
+
+	It("should only allow the provider to read secrets and config maps on the onboarding cluster", func() {
+		permissions := lscontroller.OnboardingPermissions("openmcp-system")
+		for _, resource := range []string{"secrets", "configmaps"} {
+			for _, verb := range []string{"create", "update", "patch", "delete"} {
+				Expect(isPermitted(permissions, apiRequest{group: "", resource: resource, namespace: "tenant", verb: verb})).To(BeFalse(), resource+" "+verb)
+			}
+		}
+		// config maps are neither listed nor watched
+		for _, verb := range []string{"list", "watch"} {
+			Expect(isPermitted(permissions, apiRequest{group: "", resource: "configmaps", namespace: "tenant", verb: verb})).To(BeFalse(), "configmaps "+verb)
+		}
+	})
+
+	It("should cover all requests of the reconciliation to the onboarding cluster", func() {
+		req := reconcile.Request{
+			NamespacedName: client.ObjectKey{
+				Name:      "test",
+				Namespace: "default",
+			},
+		}
+
+		accessRequestMCP, workloadClusterRequest, workloadAccessRequest := clusterAccessRequests(req)
+
+		ls := &v1alpha2.Landscaper{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      req.Name,
+				Namespace: req.Namespace,
+			},
+		}
+		identity.SetInstanceID(ls, identity.ComputeInstanceID(ls))
+		installationNamespace := identity.Instance(identity.GetInstanceID(ls)).Namespace()
+
+		tlsRoute := &gatewayv1alpha2.TLSRoute{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "webhooks-tls",
+				Namespace: installationNamespace,
+			},
+		}
+
+		recorder := &apiRecorder{}
+		env := buildTestEnvironmentReconcileWithClients("test-01", nil, recorder.wrap,
+			accessRequestMCP, workloadClusterRequest, workloadAccessRequest, tlsRoute)
+		grantClusterAccess(env, req, accessRequestMCP, workloadClusterRequest, workloadAccessRequest)
+
+		// the tenant provides a registry secret and the CA bundle on the onboarding cluster
+		Expect(env.Client().Create(env.Ctx, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "registry-credentials", Namespace: req.Namespace},
+			Type:       corev1.SecretTypeDockerConfigJson,
+			Data:       map[string][]byte{corev1.DockerConfigJsonKey: []byte(`{"auths":{"registry.example.com":{"auth":"dXNlcjpwYXNz"}}}`)},
+		})).To(Succeed())
+		Expect(env.Client().Create(env.Ctx, &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "ca-bundle", Namespace: req.Namespace},
+			Data:       map[string]string{"ca.crt": "tenant-ca"},
+		})).To(Succeed())
+
+		providerConfig := &v1alpha2.ProviderConfig{}
+		Expect(env.Client().Get(env.Ctx, client.ObjectKey{Name: "default"}, providerConfig)).To(Succeed())
+		providerConfig.Spec.CABundleRef = &v1alpha2.SourceKeyReference{
+			SourceReference: v1alpha2.SourceReference{Name: "ca-bundle", Source: v1alpha2.SourceOnboarding},
+			Key:             "ca.crt",
+		}
+		Expect(env.Client().Update(env.Ctx, providerConfig)).To(Succeed())
+
+		Expect(env.Client().Get(env.Ctx, client.ObjectKeyFromObject(ls), ls)).To(Succeed())
+		ls.Spec.HelmDeployer = &v1alpha2.HelmDeployerSpec{
+			Registries: []v1alpha2.OCIRegistry{
+				{
+					Host:      "registry.example.com",
+					SecretRef: &v1alpha2.SourceReference{Name: "registry-credentials", Source: v1alpha2.SourceOnboarding},
+				},
+			},
+		}
+		Expect(env.Client().Update(env.Ctx, ls)).To(Succeed())
+
+		env.ShouldReconcile(req, "reconcile should create the tls route")
+		setTLSRouteAccepted(env.Ctx, tlsRoute, env.Client())
+		env.ShouldReconcile(req, "reconcile should install the landscaper instance")
+
+		Expect(env.Client().Get(env.Ctx, client.ObjectKeyFromObject(ls), ls)).To(Succeed())
+		Expect(env.Client().Delete(env.Ctx, ls)).To(Succeed())
+		Eventually(func(g Gomega) {
+			_ = env.ShouldReconcile(req, "should reconcile after deletion")
+			g.Expect(env.Client().Get(env.Ctx, client.ObjectKeyFromObject(ls), ls)).ToNot(Succeed())
+		}, 10*time.Second, 1*time.Second).Should(Succeed())
+
+		Expect(recorder.requests).To(ContainElements(
+			apiRequest{group: "", resource: "secrets", namespace: req.Namespace, verb: "get"},
+			apiRequest{group: "", resource: "configmaps", namespace: req.Namespace, verb: "get"},
+		))
+		permissions := lscontroller.OnboardingPermissions("openmcp-system")
+		notPermitted := []string{}
+		for _, r := range recorder.requests {
+			if !isPermitted(permissions, r) {
+				notPermitted = append(notPermitted, r.String())
+			}
+		}
+		Expect(notPermitted).To(BeEmpty(), "the onboarding permissions must allow all requests of the provider")
+	})
+})
@@ -10,7 +10,6 @@ import (
 
 	"github.com/openmcp-project/controller-utils/pkg/clusters"
 	"github.com/openmcp-project/controller-utils/pkg/resources"
-	"github.com/openmcp-project/openmcp-operator/api/common"
 	"github.com/openmcp-project/openmcp-operator/api/provider/v1alpha1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
@@ -21,6 +20,7 @@ import (
 	core "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/sets"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
@@ -99,6 +99,24 @@ func (r *LandscaperReconciler) handleCreateUpdateOperation(ctx context.Context,
 		return reconcile.Result{}, status, err
 	}
 
+	if err = validateDeployers(ls, providerConfig); err != nil {
+		log.Error(err, "invalid deployers for landscaper instance")
+		status.setInstallProviderConfigError(err)
+		return reconcile.Result{}, status, err
+	}
+
+	if err = validateConfiguration(ls, providerConfig); err != nil {
+		log.Error(err, "invalid configuration for landscaper instance")
+		status.setInstallProviderConfigError(err)
+		return reconcile.Result{}, status, err
+	}
+
+	if err = validateDeployerConfigurations(ls); err != nil {
+		log.Error(err, "invalid deployer configuration for landscaper instance")
+		status.setInstallConfigurationError(err)
+		return reconcile.Result{}, status, err
+	}
+
 	req := reconcile.Request{NamespacedName: client.ObjectKeyFromObject(ls)}
 	res, err := r.ClusterAccessReconciler.Reconcile(ctx, req)
 	if err != nil {
@@ -135,33 +153,78 @@ func (r *LandscaperReconciler) handleCreateUpdateOperation(ctx context.Context,
 		BackendPort:     dnsServicePort(),
 	}
 
-	dnsResult, err := r.DNSReconciler.ReconcileGateway(ctx, dnsInstance, workloadCluster)
+	// without webhooks, the webhooks server needs neither a hostname nor a TLS route
+	webhooksDisabled := ls.Spec.AreAllWebhooksDisabled()
+	hostName := ""
+	if webhooksDisabled {
+		r.stopGatewayWatch(req)
+		status.setWebhooksDisabled()
+	} else {
+		r.watchGateway(ctx, req, workloadCluster)
+
+		dnsResult, err := r.DNSReconciler.ReconcileGateway(ctx, dnsInstance, workloadCluster)
+		if err != nil {
+			log.Error(err, "failed to reconcile DNS for landscaper instance")
+			status.setInstallDNSConfigFailed(err)
+			return reconcile.Result{}, status, err
+		}
+
+		if dnsResult.RequeueAfter > 0 {
+			log.Debug("waiting for DNS to be ready")
+			status.setInstallWaitForDNSReady()
+			return reconcile.Result{RequeueAfter: dnsResult.RequeueAfter}, status, nil
+		}
+
+		scope := webhooksDNSScope(ls)
+		selectedHostName, ok := dnsResult.ForScope(scope)
+		if !ok {
+			err = fmt.Errorf("gateway provides no base domain for the %s webhooks DNS scope", scope)
+			log.Error(err, "failed to select webhooks server hostname")
+			status.setInstallDNSConfigFailed(err)
+			return reconcile.Result{}, status, err
+		}
+
+		dnsStatus, migrationStarted := updateDNSStatus(ls.Status.DNS, selectedHostName, dnsResult.HostNames, metav1.Now())
+		status.DNS = dnsStatus
+		if migrationStarted {
+			log.Info("base domain of the webhooks server hostname has changed, migrating webhooks server hostname",
+				"previousBaseDomain", dnsStatus.Migration.PreviousBaseDomain, "baseDomain", dnsStatus.BaseDomain)
+			if dnsScopeOf(ls.Status.DNS) == dnsStatus.Scope {
+				// the base domain of the gateway has changed, which affects other instances as well
+				r.triggerDNSMigration(ctx, ls, dnsStatus.Migration.PreviousBaseDomain)
+			}
+		}
+		dnsInstance.AdditionalHostNames = additionalHostNames(status.DNS)
+		hostName = webhookHostName(status.DNS)
+	}
+
+	conf, err := r.createConfig(ctx, ls, mcpCluster, workloadCluster, providerConfig, hostName)
 	if err != nil {
-		log.Error(err, "failed to reconcile DNS for landscaper instance")
-		status.setInstallDNSConfigFailed(err)
+		log.Error(err, "failed to create configuration for landscaper instance")
+		status.setInstallConfigurationError(err)
 		return reconcile.Result{}, status, err
 	}
 
-	if dnsResult.RequeueAfter > 0 {
-		log.Debug("waiting for DNS to be ready")
-		status.setInstallWaitForDNSReady()
-		return reconcile.Result{RequeueAfter: dnsResult.RequeueAfter}, status, nil
+	conf.Landscaper.OCI, err = r.landscaperOCIConfig(ctx, ls, providerConfig)
+	if err != nil {
+		log.Error(err, "failed to read registry credentials for landscaper instance")
+		status.setInstallConfigurationError(err)
+		return reconcile.Result{}, status, err
 	}
 
-	conf, err := r.createConfig(ctx, ls, mcpCluster, workloadCluster, providerConfig, dnsResult.HostName)
+	conf.HelmDeployer.OCI, err = r.helmDeployerOCIConfig(ctx, ls, providerConfig)
 	if err != nil {
-		log.Error(err, "failed to create configuration for landscaper instance")
+		log.Error(err, "failed to read registry credentials for landscaper instance")
 		status.setInstallConfigurationError(err)
 		return reconcile.Result{}, status, err
 	}
 
 	if providerConfig.Spec.CABundleRef != nil {
-		if err := resources.CreateOrUpdateResource(ctx, conf.WorkloadCluster.Client(), resources.NewNamespaceMutator(conf.Instance.Namespace())); err != nil {
+		if err := resources.CreateOrUpdateResource(ctx, conf.WorkloadCluster.Client(), conf.Instance.NamespaceMutator()); err != nil {
 			return reconcile.Result{}, status, err
 		}
 		caConfigMapSync := configmapsync.ConfigMapSync{
-			PlatformCluster:          r.PlatformCluster,
-			PlatformClusterNamespace: conf.PlatformClusterNamespace,
+			Sources:                  conf.Sources,
 			WorkloadCluster:          conf.WorkloadCluster,
 			WorkloadClusterNamespace: conf.Instance.Namespace(),
 		}
@@ -174,6 +237,8 @@ func (r *LandscaperReconciler) handleCreateUpdateOperation(ctx context.Context,
 		conf.CaConfigMap = caConfigMap
 	}
 
+	// a deployer is recorded before its installation, so that it is uninstalled even if its installation fails
+	status.Deployers = sets.List(sets.New(ls.Status.Deployers...).Insert(deployerNames(conf.Deployers)...))
 	if err := instance.InstallLandscaperInstance(ctx, conf); err != nil {
 		log.Error(err, "failed to install landscaper instance")
 		status.setInstallFailed(err)
@@ -181,39 +246,92 @@ func (r *LandscaperReconciler) handleCreateUpdateOperation(ctx context.Context,
 	}
 	log.Debug("landscaper instance has been installed")
 	status.setInstalled()
+	status.Deployers = deployerNames(conf.Deployers)
 
-	if err = r.DNSReconciler.ReconcileTLSRoute(ctx, dnsInstance, workloadCluster); err != nil {
-		log.Error(err, "failed to reconcile TLS route for landscaper instance")
-		status.setInstallDNSConfigFailed(err)
-		return reconcile.Result{}, status, err
+	if status.Recommendations, err = instance.ResourceRecommendations(ctx, conf); err != nil {
+		log.Error(err, "failed to read resource recommendations for landscaper instance")
 	}
 
-	tlsReady, err := r.DNSReconciler.IsTLSRouteReady(ctx, dnsInstance, workloadCluster)
-	if err != nil {
-		log.Error(err, "failed to check TLS route for landscaper instance")
-		status.setInstallDNSConfigFailed(err)
-		return reconcile.Result{}, status, err
-	}
-	if !tlsReady {
-		log.Debug("TLS route is not yet ready")
-		status.setInstallWaitForDNSReady()
-		return reconcile.Result{RequeueAfter: 20 * time.Second}, status, nil
+	if webhooksDisabled {
+		// the webhooks server has been uninstalled with the landscaper instance, so that it cannot register itself again
+		if err = r.removeWebhooksRegistration(ctx, dnsInstance, mcpCluster, workloadCluster); err != nil {
+			log.Error(err, "failed to remove webhooks registration of landscaper instance")
+			status.setInstallDNSConfigFailed(err)
+			return reconcile.Result{}, status, err
+		}
+	} else {
+		if err = r.DNSReconciler.ReconcileTLSRoute(ctx, dnsInstance, workloadCluster); err != nil {
+			log.Error(err, "failed to reconcile TLS route for landscaper instance")
+			status.setInstallDNSConfigFailed(err)
+			return reconcile.Result{}, status, err
+		}
+
+		tlsRouteStatus, err := r.DNSReconciler.CheckTLSRoute(ctx, dnsInstance, workloadCluster)
+		if err != nil {
+			log.Error(err, "failed to check TLS route for landscaper instance")
+			status.setInstallDNSConfigFailed(err)
+			return reconcile.Result{}, status, err
+		}
+		if !tlsRouteStatus.Ready {
+			log.Debug("TLS route is not yet ready", "reason", tlsRouteStatus.Reason, "message", tlsRouteStatus.Message)
+			status.setInstallTLSRouteNotReady(tlsRouteStatus)
+			return reconcile.Result{RequeueAfter: 20 * time.Second}, status, nil
+		}
+
+		if isDNSMigrationRouting(status.DNS) {
+			// both hostnames are routed now, so the webhooks server can be switched to the new hostname
+			log.Info("new hostname is routed, switching webhooks server", "hostName", status.DNS.HostName)
+			status.DNS.Migration.Phase = v1alpha2.DNSMigrationPhaseSwitched
+			status.DNS.Migration.LastTransitionTime = metav1.Now()
+			return reconcile.Result{RequeueAfter: dnsMigrationSwitchInterval}, status, nil
+		}
 	}
 
 	if readinessCheckResult := instance.CheckReadiness(ctx, conf); !readinessCheckResult.IsReady() {
 		log.Debug("landscaper instance is not yet ready")
 		status.setWaitForReadinessCheck(readinessCheckResult)
+		if message, err := instance.ExhaustedResourceQuota(ctx, conf); err != nil {
+			log.Error(err, "failed to check the resource quota of the landscaper instance")
+		} else if message != "" {
+			log.Info("resource quota of landscaper instance is exhausted", "message", message)
+			status.setQuotaExhausted(message)
+		}
 		return ctrl.Result{RequeueAfter: 40 * time.Second}, status, nil
 	}
 
+	if !webhooksDisabled {
+		if err := r.probeWebhook(ctx, mcpCluster, hostName); err != nil {
+			log.Info("webhooks server is not reachable", "error", err.Error())
+			status.setWebhookUnreachable(err)
+			return ctrl.Result{RequeueAfter: 40 * time.Second}, status, nil
+		}
+		status.setWebhookReachable()
+	}
+
 	ls.Status.Phase = v1alpha2.PhaseReady
 	log.Debug("landscaper instance has become ready")
 	status.setReady()
 
+	if status.DNS != nil && status.DNS.Migration != nil {
+		if remaining := r.DNSMigrationGracePeriod - time.Since(status.DNS.Migration.LastTransitionTime.Time); remaining > 0 {
+			log.Debug("keeping previous hostname routed until the grace period has expired", "remaining", remaining)
+			return reconcile.Result{RequeueAfter: remaining}, status, nil
+		}
+
+		status.DNS.Migration = nil
+		dnsInstance.AdditionalHostNames = nil
+		if err = r.DNSReconciler.ReconcileTLSRoute(ctx, dnsInstance, workloadCluster); err != nil {
+			log.Error(err, "failed to remove previous hostname from TLS route for landscaper instance")
+			status.setInstallDNSConfigFailed(err)
+			return reconcile.Result{}, status, err
+		}
+		log.Info("migration of webhooks server hostname has finished", "hostName", status.DNS.HostName)
+	}
+
 	return reconcile.Result{
-		// reconcile after 10 minutes to ensure that the landscaper instance is still healthy
-		// and to fetch new tokens for the access requests
-		RequeueAfter: 10 * time.Minute,
+		// reconcile periodically to ensure that the landscaper instance is still healthy,
+		// and ahead of the expiration of the tokens to refresh the kubeconfig secrets
+		RequeueAfter: r.requeueAfterReady(ctx, req, conf),
 	}, status, nil
 }
 
@@ -230,6 +348,7 @@ func (r *LandscaperReconciler) handleDeleteOperation(ctx context.Context, ls *v1
 	}
 
 	req := reconcile.Request{NamespacedName: client.ObjectKeyFromObject(ls)}
+	r.stopGatewayWatch(req)
 
 	accessRequestsInDeletion, err := r.areAccessRequestsInDeletion(ctx, req)
 	if err != nil {
@@ -490,22 +609,26 @@ func (r *LandscaperReconciler) createConfig(ctx context.Context, ls *v1alpha2.La
 		return nil, err
 	}
 
-	getImagePullSecrets := func(imageConfiguration *v1alpha2.ImageConfiguration) []common.LocalObjectReference {
+	getImagePullSecrets := func(imageConfiguration *v1alpha2.ImageConfiguration) []v1alpha2.SourceReference {
 		if imageConfiguration == nil {
-			return serviceProvider.Spec.ImagePullSecrets
+			// the image pull secrets of the service provider are located in its namespace
+			refs := make([]v1alpha2.SourceReference, 0, len(serviceProvider.Spec.ImagePullSecrets))
+			for _, ref := range serviceProvider.Spec.ImagePullSecrets {
+				refs = append(refs, v1alpha2.SourceReference{Name: ref.Name})
+			}
+			return refs
 		}
 
 		return imageConfiguration.ImagePullSecrets
 	}
 
 	conf := &instance.Configuration{
-		Instance:                 inst,
-		Version:                  ls.Spec.Version,
-		PlatformCluster:          r.PlatformCluster,
-		PlatformClusterNamespace: r.ProviderNamespace,
-		MCPCluster:               mcpCluster,
-		WorkloadCluster:          workloadCluster,
-		WorkloadClusterDomain:    fmt.Sprintf("https://%s:%d", workloadClusterDomain, dnsServicePort()), // 9443 is the port for TLS passthrough configured in the Gateway
+		Instance:              inst,
+		Version:               ls.Spec.Version,
+		Sources:               r.sources(ls),
+		MCPCluster:            mcpCluster,
+		WorkloadCluster:       workloadCluster,
+		WorkloadClusterDomain: webhookURL(workloadClusterDomain), // 9443 is the port for TLS passthrough configured in the Gateway
 		Landscaper: instance.LandscaperConfig{
 			Controller: instance.ControllerConfig{
 				Image: v1alpha2.ImageConfiguration{
@@ -514,8 +637,10 @@ func (r *LandscaperReconciler) createConfig(ctx context.Context, ls *v1alpha2.La
 				},
 				Resources:     resources,
 				ResourcesMain: resources,
+				Configuration: ls.Spec.Configuration,
 			},
 			WebhooksServer: instance.WebhooksServerConfig{
+				DisableWebhooks: disabledWebhooks(ls),
 				Image: v1alpha2.ImageConfiguration{
 					Image:            providerConfig.GetLandscaperWebhooksServerImageLocation(ls.Spec.Version),
 					ImagePullSecrets: getImagePullSecrets(providerConfig.Spec.Deployment.LandscaperWebhooksServer),
@@ -526,20 +651,39 @@ func (r *LandscaperReconciler) createConfig(ctx context.Context, ls *v1alpha2.La
 			},
 		},
 		ManifestDeployer: instance.ManifestDeployerConfig{
+			Disabled: ls.Spec.ManifestDeployer.IsDisabled(),
 			Image: v1alpha2.ImageConfiguration{
 				Image:            providerConfig.GetManifestDeployerImageLocation(ls.Spec.Version),
 				ImagePullSecrets: getImagePullSecrets(providerConfig.Spec.Deployment.ManifestDeployer),
 			},
-			Resources: resources,
+			Resources:     resources,
+			Configuration: ls.Spec.ManifestDeployer.GetConfiguration(),
 		},
 		HelmDeployer: instance.HelmDeployerConfig{
+			Disabled: ls.Spec.HelmDeployer.IsDisabled(),
 			Image: v1alpha2.ImageConfiguration{
 				Image:            providerConfig.GetHelmDeployerImageLocation(ls.Spec.Version),
 				ImagePullSecrets: getImagePullSecrets(providerConfig.Spec.Deployment.HelmDeployer),
 			},
-			Resources: resources,
+			Resources:     resources,
+			Configuration: ls.Spec.HelmDeployer.GetConfiguration(),
 		},
 	}
+	conf.Landscaper.OCICache = ociCacheConfig(ls, providerConfig)
+	conf.Scheduling = schedulingValues(providerConfig)
+	conf.VerticalScaling = verticalScalingValues(providerConfig)
+	if hs := providerConfig.Spec.HorizontalScaling; hs != nil {
+		conf.Landscaper.Controller.HPAMain = hpaValues(hs.LandscaperController)
+		conf.Landscaper.WebhooksServer.HPA = hpaValues(hs.WebhooksServer)
+		conf.ManifestDeployer.HPA = hpaValues(hs.ManifestDeployer)
+		conf.HelmDeployer.HPA = hpaValues(hs.HelmDeployer)
+	}
+	conf.PodSecurityContext = providerConfig.Spec.Security.GetPodSecurityContext()
+	conf.SecurityContext = providerConfig.Spec.Security.GetSecurityContext()
+	conf.PodSecurityLevel = string(podSecurityLevel(ls, providerConfig))
+	conf.NetworkPolicies = networkPolicyValues(providerConfig)
+	conf.ResourceQuota = resourceQuotaConfig(ls, providerConfig)
+	conf.Deployers, conf.RemovedDeployers = deployerConfigs(ls, providerConfig, resources, getImagePullSecrets)
 	return conf, nil
 }
 
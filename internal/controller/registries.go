@@ -0,0 +1,190 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/openmcp-project/service-provider-landscaper/api/v1alpha2"
+	"github.com/openmcp-project/service-provider-landscaper/internal/installer/instance"
+	"github.com/openmcp-project/service-provider-landscaper/internal/shared/sources"
+)
+
+// helmDeployerOCIConfig reads the credentials of the OCI registries configured for the helm deployer.
+// It returns nil if no registries are configured.
+func (r *LandscaperReconciler) helmDeployerOCIConfig(ctx context.Context, ls *v1alpha2.Landscaper,
+	providerConfig *v1alpha2.ProviderConfig) (*instance.OCIConfig, error) {
+	return r.ociConfig(ctx, ls, providerConfig, nil, ls.Spec.HelmDeployer.GetRegistries())
+}
+
+// landscaperOCIConfig reads the credentials of the OCI registries configured for the Landscaper,
+// which are the registries of the ProviderConfig and those added by the Landscaper resource.
+// It returns nil if no registries are configured.
+func (r *LandscaperReconciler) landscaperOCIConfig(ctx context.Context, ls *v1alpha2.Landscaper,
+	providerConfig *v1alpha2.ProviderConfig) (*instance.OCIConfig, error) {
+	return r.ociConfig(ctx, ls, providerConfig, providerConfig.Spec.Registry.GetRegistries(), ls.Spec.Registry.GetRegistries())
+}
+
+// ociConfig reads the credentials of the given registries of the ProviderConfig and of the Landscaper resource.
+// The secrets of the ProviderConfig registries are always read from the platform cluster.
+func (r *LandscaperReconciler) ociConfig(ctx context.Context, ls *v1alpha2.Landscaper, providerConfig *v1alpha2.ProviderConfig,
+	providerRegistries, registries []v1alpha2.OCIRegistry) (*instance.OCIConfig, error) {
+	if len(providerRegistries) == 0 && len(registries) == 0 {
+		return nil, nil
+	}
+
+	ociConfig := &instance.OCIConfig{
+		ConfigFiles: map[string][]byte{},
+	}
+
+	addRegistry := func(registry v1alpha2.OCIRegistry, fromProviderConfig bool) error {
+		ociConfig.AllowPlainHTTP = ociConfig.AllowPlainHTTP || registry.AllowPlainHTTP
+		ociConfig.InsecureSkipVerify = ociConfig.InsecureSkipVerify || registry.InsecureSkipVerify
+
+		if registry.SecretRef == nil {
+			return nil
+		}
+
+		ref := *registry.SecretRef
+		if !fromProviderConfig {
+			var err error
+			if ref, err = resolveRegistrySecret(providerConfig, ref); err != nil {
+				return fmt.Errorf("secret of registry %s: %w", registry.Host, err)
+			}
+		}
+
+		fileName := registrySecretFileName(ref)
+		if _, ok := ociConfig.ConfigFiles[fileName]; ok {
+			return nil
+		}
+
+		content, err := r.readRegistrySecret(ctx, ls, ref)
+		if err != nil {
+			return fmt.Errorf("failed to read credentials of registry %s: %w", registry.Host, err)
+		}
+		ociConfig.ConfigFiles[fileName] = content
+		return nil
+	}
+
+	for _, registry := range providerRegistries {
+		if err := addRegistry(registry, true); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, registry := range registries {
+		if err := addRegistry(registry, false); err != nil {
+			return nil, err
+		}
+	}
+
+	return ociConfig, nil
+}
+
+// resolveRegistrySecret resolves a registry secret which a Landscaper resource references. Secrets with source Platform
+// must be offered in the registry secrets of the ProviderConfig, which determine their location. Secrets with source
+// Onboarding are read from the namespace of the Landscaper resource. Other sources are only available through an offer.
+func resolveRegistrySecret(providerConfig *v1alpha2.ProviderConfig, ref v1alpha2.SourceReference) (v1alpha2.SourceReference, error) {
+	if ref.Namespace != "" {
+		return ref, fmt.Errorf("reference %s must not specify a namespace", ref.Name)
+	}
+
+	switch ref.GetSource() {
+	case v1alpha2.SourceOnboarding:
+		return ref, nil
+	case v1alpha2.SourcePlatform:
+		offered := providerConfig.Spec.GetRegistrySecret(ref.Name)
+		if offered == nil {
+			return ref, fmt.Errorf("secret %s is not offered as registry secret in provider config %s", ref.Name, providerConfig.Name)
+		}
+		return *offered, nil
+	default:
+		return ref, fmt.Errorf("reference %s has source %s, which is only available through the registry secrets of provider config %s",
+			ref.Name, ref.GetSource(), providerConfig.Name)
+	}
+}
+
+// readRegistrySecret returns the docker config of the referenced registry secret.
+func (r *LandscaperReconciler) readRegistrySecret(ctx context.Context, ls *v1alpha2.Landscaper, ref v1alpha2.SourceReference) ([]byte, error) {
+	data, err := r.sources(ls).Secret(ctx, ref)
+	if err != nil {
+		return nil, err
+	}
+
+	content, ok := data[corev1.DockerConfigJsonKey]
+	if !ok || len(content) == 0 {
+		return nil, fmt.Errorf("secret %s has no %s key", ref.Name, corev1.DockerConfigJsonKey)
+	}
+	return content, nil
+}
+
+// sources returns the resolver for the secrets and config maps which are referenced for the Landscaper instance.
+func (r *LandscaperReconciler) sources(ls *v1alpha2.Landscaper) *sources.Resolver {
+	return &sources.Resolver{
+		PlatformCluster:            r.PlatformCluster,
+		PlatformClusterNamespace:   r.ProviderNamespace,
+		OnboardingCluster:          r.OnboardingCluster,
+		OnboardingClusterNamespace: ls.Namespace,
+		External:                   r.ExternalSecretStore,
+	}
+}
+
+// ociCacheConfig returns the cache configuration of the Landscaper resource, or else of the ProviderConfig.
+// It returns nil if neither configures the cache.
+func ociCacheConfig(ls *v1alpha2.Landscaper, providerConfig *v1alpha2.ProviderConfig) *instance.OCICacheConfig {
+	cache := ls.Spec.Registry.GetCache()
+	if cache == nil {
+		cache = providerConfig.Spec.Registry.GetCache()
+	}
+	if cache == nil {
+		return nil
+	}
+
+	conf := &instance.OCICacheConfig{
+		UseInMemoryOverlay: cache.UseInMemoryOverlay,
+		SizeLimit:          cache.SizeLimit,
+		Medium:             cache.Medium,
+	}
+	if cache.Persistent != nil {
+		conf.Persistent = &instance.PersistentCacheConfig{
+			StorageClassName: cache.Persistent.StorageClassName,
+			Size:             cache.Persistent.Size,
+		}
+	}
+	return conf
+}
+
+// registrySecretFileName returns the name of the docker config file in which the Landscaper or deployer finds the content
+// of the secret. The name contains the source and the namespace, so that secrets of different locations do not collide.
+func registrySecretFileName(ref v1alpha2.SourceReference) string {
+	if ref.Namespace != "" {
+		return fmt.Sprintf("%s-%s-%s.json", strings.ToLower(string(ref.GetSource())), ref.Namespace, ref.Name)
+	}
+	return fmt.Sprintf("%s-%s.json", strings.ToLower(string(ref.GetSource())), ref.Name)
+}
+
+// referencesRegistrySecret checks whether the Landscaper references the given secret of its namespace as registry secret.
+func referencesRegistrySecret(ls *v1alpha2.Landscaper, secretName string) bool {
+	registries := slices.Concat(ls.Spec.Registry.GetRegistries(), ls.Spec.HelmDeployer.GetRegistries())
+	for _, registry := range registries {
+		if registry.SecretRef != nil && registry.SecretRef.GetSource() == v1alpha2.SourceOnboarding &&
+			registry.SecretRef.Name == secretName {
+			return true
+		}
+	}
+	return false
+}
+
+// providerRegistrySecrets returns the references of the secrets of the registries in the ProviderConfig.
+func providerRegistrySecrets(providerConfig *v1alpha2.ProviderConfig) []v1alpha2.SourceReference {
+	refs := []v1alpha2.SourceReference{}
+	for _, registry := range providerConfig.Spec.Registry.GetRegistries() {
+		if registry.SecretRef != nil {
+			refs = append(refs, *registry.SecretRef)
+		}
+	}
+	return refs
+}
@@ -0,0 +1,44 @@
+package controller
+
+import (
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/openmcp-project/service-provider-landscaper/api/v1alpha2"
+	"github.com/openmcp-project/service-provider-landscaper/internal/installer/instance"
+)
+
+// resourceQuotaConfig converts the resource quota settings of the provider config into the configuration of the instance,
+// filling in the defaults. It returns nil if the resource quota is disabled.
+// Unless the provider config sets a headroom, instances with the container deployer get one for the pods of their deploy items,
+// which run in the same namespace.
+func resourceQuotaConfig(ls *v1alpha2.Landscaper, providerConfig *v1alpha2.ProviderConfig) *instance.ResourceQuotaConfig {
+	s := providerConfig.Spec.ResourceQuota
+	if s == nil {
+		s = &v1alpha2.ResourceQuotaSpec{}
+	}
+	if s.Disabled {
+		return nil
+	}
+
+	c := &instance.ResourceQuotaConfig{
+		Headroom:       s.Headroom,
+		DefaultRequest: s.DefaultRequest,
+	}
+	if c.Headroom == nil && slices.Contains(ls.Spec.Deployers, v1alpha2.DeployerContainer) {
+		c.Headroom = corev1.ResourceList{
+			corev1.ResourcePods:           resource.MustParse("10"),
+			corev1.ResourceRequestsCPU:    resource.MustParse("1"),
+			corev1.ResourceRequestsMemory: resource.MustParse("1Gi"),
+		}
+	}
+	if c.DefaultRequest == nil {
+		c.DefaultRequest = corev1.ResourceList{
+			corev1.ResourceCPU:    resource.MustParse("10m"),
+			corev1.ResourceMemory: resource.MustParse("32Mi"),
+		}
+	}
+	return c
+}
@@ -0,0 +1,44 @@
+package controller
+
+import (
+	"k8s.io/utils/ptr"
+
+	"github.com/openmcp-project/service-provider-landscaper/api/v1alpha2"
+	"github.com/openmcp-project/service-provider-landscaper/internal/shared/types"
+)
+
+// verticalScalingValues converts the vertical scaling settings of the provider config into the values of the installers.
+// It returns nil if the provider config has no vertical scaling settings.
+func verticalScalingValues(providerConfig *v1alpha2.ProviderConfig) *types.VerticalScalingValues {
+	s := providerConfig.Spec.VerticalScaling
+	if s == nil {
+		return nil
+	}
+
+	v := &types.VerticalScalingValues{
+		UpdateMode: string(s.UpdateMode),
+		MinAllowed: s.MinAllowed,
+		MaxAllowed: s.MaxAllowed,
+	}
+	if v.UpdateMode == "" {
+		v.UpdateMode = string(v1alpha2.VerticalScalingUpdateModeOff)
+	}
+	return v
+}
+
+// hpaValues converts the horizontal scaling settings of a component in the provider config into the values of the installers.
+// Unset fields are defaulted by the installers.
+func hpaValues(s *v1alpha2.HorizontalPodAutoscalerSpec) types.HPAValues {
+	if s == nil {
+		return types.HPAValues{}
+	}
+
+	return types.HPAValues{
+		MinReplicas:              s.MinReplicas,
+		MaxReplicas:              ptr.Deref(s.MaxReplicas, 0),
+		AverageCpuUtilization:    s.AverageCPUUtilization,
+		AverageMemoryUtilization: s.AverageMemoryUtilization,
+		Behavior:                 s.Behavior,
+		Metrics:                  s.Metrics,
+	}
+}
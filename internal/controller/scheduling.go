@@ -0,0 +1,39 @@
+package controller
+
+import (
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/openmcp-project/service-provider-landscaper/api/v1alpha2"
+	"github.com/openmcp-project/service-provider-landscaper/internal/shared/types"
+)
+
+// schedulingValues converts the scheduling settings of the provider config into the values of the installers.
+// It returns nil if the provider config has no scheduling settings.
+func schedulingValues(providerConfig *v1alpha2.ProviderConfig) *types.SchedulingValues {
+	s := providerConfig.Spec.Scheduling
+	if s == nil {
+		return nil
+	}
+
+	v := &types.SchedulingValues{
+		NodeSelector:      s.NodeSelector,
+		Tolerations:       s.Tolerations,
+		Affinity:          s.Affinity,
+		PriorityClassName: s.PriorityClassName,
+	}
+	for _, c := range s.TopologySpreadConstraints {
+		tsc := corev1.TopologySpreadConstraint{
+			MaxSkew:           c.MaxSkew,
+			TopologyKey:       c.TopologyKey,
+			WhenUnsatisfiable: c.WhenUnsatisfiable,
+		}
+		if tsc.MaxSkew == 0 {
+			tsc.MaxSkew = 1
+		}
+		if tsc.WhenUnsatisfiable == "" {
+			tsc.WhenUnsatisfiable = corev1.ScheduleAnyway
+		}
+		v.TopologySpreadConstraints = append(v.TopologySpreadConstraints, tsc)
+	}
+	return v
+}
@@ -0,0 +1,20 @@
+package controller
+
+import (
+	"slices"
+
+	"github.com/openmcp-project/service-provider-landscaper/api/v1alpha2"
+)
+
+// podSecurityLevel returns the Pod Security Standard which is enforced in the namespace of the Landscaper instance.
+// Unless the provider config sets a level, it is restricted, or baseline if the instance runs the container deployer,
+// because the pods of its deploy items run in the same namespace.
+func podSecurityLevel(ls *v1alpha2.Landscaper, providerConfig *v1alpha2.ProviderConfig) v1alpha2.PodSecurityLevel {
+	if s := providerConfig.Spec.Security; s != nil && s.EnforceLevel != "" {
+		return s.EnforceLevel
+	}
+	if slices.Contains(ls.Spec.Deployers, v1alpha2.DeployerContainer) {
+		return v1alpha2.PodSecurityLevelBaseline
+	}
+	return v1alpha2.PodSecurityLevelRestricted
+}
@@ -6,6 +6,7 @@ import (
 	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/openmcp-project/service-provider-landscaper/api/v1alpha2"
+	"github.com/openmcp-project/service-provider-landscaper/internal/dns"
 )
 
 const (
@@ -16,8 +17,17 @@ type reconcileStatus struct {
 	InstallCondition   *meta.Condition
 	ReadyCondition     *meta.Condition
 	UninstallCondition *meta.Condition
-	ObservedGeneration int64
-	Phase              v1alpha2.LandscaperPhase
+	// WebhookReachableCondition is only set if the webhooks server has been probed.
+	WebhookReachableCondition *meta.Condition
+	ObservedGeneration        int64
+	Phase                     v1alpha2.LandscaperPhase
+	DNS                       *v1alpha2.DNSStatus
+	// Deployers are the installed additional deployers. They are only written to the status if not nil.
+	Deployers []string
+	// Recommendations are the resource recommendations of the vertical pod autoscalers. They are only written to the status if not nil.
+	Recommendations []v1alpha2.ResourceRecommendation
+	// WebhooksDisabled removes the DNS status and the WebhookReachable condition, which are obsolete without webhooks server.
+	WebhooksDisabled bool
 }
 
 func (s *reconcileStatus) setInstallWaitForClusterAccessReady() {
@@ -70,6 +80,18 @@ func (s *reconcileStatus) setWaitForReadinessCheck(result readiness.CheckResult)
 	}
 }
 
+// setQuotaExhausted replaces the reason of a pending readiness check if the resource quota of the instance is exhausted,
+// which prevents the creation of pods.
+func (s *reconcileStatus) setQuotaExhausted(message string) {
+	s.ReadyCondition = &meta.Condition{
+		Type:               v1alpha2.ConditionTypeReady,
+		Status:             meta.ConditionFalse,
+		ObservedGeneration: s.ObservedGeneration,
+		Reason:             v1alpha2.ConditionReasonQuotaExhausted,
+		Message:            message,
+	}
+}
+
 func (s *reconcileStatus) setReady() {
 	s.ReadyCondition = &meta.Condition{
 		Type:               v1alpha2.ConditionTypeReady,
@@ -82,6 +104,38 @@ func (s *reconcileStatus) setReady() {
 	s.Phase = v1alpha2.PhaseReady
 }
 
+func (s *reconcileStatus) setWebhookReachable() {
+	s.WebhookReachableCondition = &meta.Condition{
+		Type:               v1alpha2.ConditionTypeWebhookReachable,
+		Status:             meta.ConditionTrue,
+		ObservedGeneration: s.ObservedGeneration,
+		Reason:             v1alpha2.ConditionReasonWebhookReachable,
+		Message:            "Webhooks server is reachable",
+	}
+}
+
+func (s *reconcileStatus) setWebhooksDisabled() {
+	s.WebhooksDisabled = true
+}
+
+func (s *reconcileStatus) setWebhookUnreachable(err error) {
+	s.WebhookReachableCondition = &meta.Condition{
+		Type:               v1alpha2.ConditionTypeWebhookReachable,
+		Status:             meta.ConditionFalse,
+		ObservedGeneration: s.ObservedGeneration,
+		Reason:             v1alpha2.ConditionReasonWebhookUnreachable,
+		Message:            err.Error(),
+	}
+
+	s.ReadyCondition = &meta.Condition{
+		Type:               v1alpha2.ConditionTypeReady,
+		Status:             meta.ConditionFalse,
+		ObservedGeneration: s.ObservedGeneration,
+		Reason:             v1alpha2.ConditionReasonWebhookUnreachable,
+		Message:            "Webhooks server is not reachable",
+	}
+}
+
 func (s *reconcileStatus) setInstallFailed(err error) {
 	s.InstallCondition = &meta.Condition{
 		Type:               v1alpha2.ConditionTypeInstalled,
@@ -182,6 +236,36 @@ func (s *reconcileStatus) setInstallWaitForDNSReady() {
 	}
 }
 
+func (s *reconcileStatus) setInstallTLSRouteNotReady(routeStatus dns.TLSRouteStatus) {
+	s.InstallCondition = &meta.Condition{
+		Type:               v1alpha2.ConditionTypeInstalled,
+		Status:             meta.ConditionFalse,
+		ObservedGeneration: s.ObservedGeneration,
+		Reason:             tlsRouteConditionReason(routeStatus.Reason),
+		Message:            routeStatus.Message,
+	}
+}
+
+// tlsRouteConditionReason maps the reason why a TLSRoute is not ready to a condition reason.
+func tlsRouteConditionReason(reason dns.TLSRouteNotReadyReason) string {
+	switch reason {
+	case dns.ReasonNoMatchingListener:
+		return v1alpha2.ConditionReasonNoMatchingListener
+	case dns.ReasonGatewayNotProgrammed:
+		return v1alpha2.ConditionReasonGatewayNotProgrammed
+	case dns.ReasonRouteNotAccepted:
+		return v1alpha2.ConditionReasonRouteNotAccepted
+	case dns.ReasonBackendNotFound:
+		return v1alpha2.ConditionReasonBackendNotFound
+	case dns.ReasonRefNotPermitted:
+		return v1alpha2.ConditionReasonRefNotPermitted
+	case dns.ReasonRefsNotResolved:
+		return v1alpha2.ConditionReasonBackendRefsNotResolved
+	default:
+		return v1alpha2.ConditionReasonWaitForDNSReady
+	}
+}
+
 func (s *reconcileStatus) SetUninstallDNSConfigFailed(err error) {
 	s.UninstallCondition = &meta.Condition{
 		Type:               v1alpha2.ConditionTypeUninstalled,
@@ -235,6 +319,29 @@ func (s *reconcileStatus) convertToLandscaperStatus(status *v1alpha2.LandscaperS
 	status.ObservedGeneration = s.ObservedGeneration
 	status.Phase = s.Phase
 
+	if s.DNS != nil {
+		status.DNS = s.DNS
+	}
+
+	if s.WebhooksDisabled {
+		status.DNS = nil
+		apimeta.RemoveStatusCondition(&status.Conditions, v1alpha2.ConditionTypeWebhookReachable)
+	}
+
+	if s.Deployers != nil {
+		status.Deployers = nil
+		if len(s.Deployers) > 0 {
+			status.Deployers = s.Deployers
+		}
+	}
+
+	if s.Recommendations != nil {
+		status.Recommendations = nil
+		if len(s.Recommendations) > 0 {
+			status.Recommendations = s.Recommendations
+		}
+	}
+
 	if s.InstallCondition != nil {
 		apimeta.SetStatusCondition(&status.Conditions, *s.InstallCondition)
 	} else {
@@ -252,4 +359,8 @@ func (s *reconcileStatus) convertToLandscaperStatus(status *v1alpha2.LandscaperS
 	} else {
 		apimeta.RemoveStatusCondition(&status.Conditions, v1alpha2.ConditionTypeUninstalled)
 	}
+
+	if s.WebhookReachableCondition != nil {
+		apimeta.SetStatusCondition(&status.Conditions, *s.WebhookReachableCondition)
+	}
 }
@@ -0,0 +1,58 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/openmcp-project/controller-utils/pkg/clusters"
+	admissionv1 "k8s.io/api/admissionregistration/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/openmcp-project/service-provider-landscaper/internal/dns"
+)
+
+const (
+	// webhookConfigurationName is the name of the ValidatingWebhookConfiguration
+	// that the Landscaper webhooks server registers in the MCP cluster.
+	webhookConfigurationName = "landscaper-validation-webhook"
+)
+
+// probeWebhook checks that the webhooks server is registered in the MCP cluster under the given hostname,
+// and that it is reachable under this hostname with a certificate matching the registered CA bundle.
+func (r *LandscaperReconciler) probeWebhook(ctx context.Context, mcpCluster *clusters.Cluster, hostName string) error {
+	webhookConfig := &admissionv1.ValidatingWebhookConfiguration{}
+	if err := mcpCluster.Client().Get(ctx, client.ObjectKey{Name: webhookConfigurationName}, webhookConfig); err != nil {
+		if apierrors.IsNotFound(err) {
+			return fmt.Errorf("validating webhook configuration %s not found in MCP cluster", webhookConfigurationName)
+		}
+		return fmt.Errorf("failed to get validating webhook configuration %s: %w", webhookConfigurationName, err)
+	}
+
+	caBundle := registeredCABundle(webhookConfig, webhookURL(hostName))
+	if len(caBundle) == 0 {
+		return fmt.Errorf("validating webhook configuration %s has no webhook with a CA bundle registered for %s", webhookConfigurationName, webhookURL(hostName))
+	}
+
+	return r.WebhookProbe.Probe(ctx, dns.WebhookAddress(hostName, dnsServicePort()), hostName, caBundle)
+}
+
+// registeredCABundle returns the CA bundle of the first webhook whose URL belongs to the given base URL.
+func registeredCABundle(webhookConfig *admissionv1.ValidatingWebhookConfiguration, baseURL string) []byte {
+	for _, webhook := range webhookConfig.Webhooks {
+		url := webhook.ClientConfig.URL
+		if url == nil || (*url != baseURL && !strings.HasPrefix(*url, baseURL+"/")) {
+			continue
+		}
+		if len(webhook.ClientConfig.CABundle) > 0 {
+			return webhook.ClientConfig.CABundle
+		}
+	}
+	return nil
+}
+
+// webhookURL returns the URL under which the webhooks server with the given hostname is registered.
+func webhookURL(hostName string) string {
+	return fmt.Sprintf("https://%s:%d", hostName, dnsServicePort())
+}
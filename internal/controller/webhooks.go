@@ -0,0 +1,43 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/openmcp-project/controller-utils/pkg/clusters"
+	admissionv1 "k8s.io/api/admissionregistration/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/openmcp-project/service-provider-landscaper/api/v1alpha2"
+	"github.com/openmcp-project/service-provider-landscaper/internal/dns"
+)
+
+// disabledWebhooks returns the webhooks which are disabled for the webhooks server.
+// If all webhooks are disabled, only "all" is returned, so that the webhooks server is not installed.
+func disabledWebhooks(ls *v1alpha2.Landscaper) []string {
+	if ls.Spec.AreAllWebhooksDisabled() {
+		return []string{string(v1alpha2.WebhookAll)}
+	}
+	webhooks := make([]string, 0, len(ls.Spec.DisableWebhooks))
+	for _, w := range ls.Spec.DisableWebhooks {
+		webhooks = append(webhooks, string(w))
+	}
+	return webhooks
+}
+
+// removeWebhooksRegistration deletes the TLSRoute of the webhooks server and the ValidatingWebhookConfiguration
+// in the MCP cluster. They remain from the time before all webhooks have been disabled.
+func (r *LandscaperReconciler) removeWebhooksRegistration(ctx context.Context, dnsInstance *dns.Instance,
+	mcpCluster, workloadCluster *clusters.Cluster) error {
+	if err := r.DNSReconciler.DeleteTLSRoute(ctx, dnsInstance, workloadCluster); err != nil {
+		return fmt.Errorf("failed to delete TLS route of the webhooks server: %w", err)
+	}
+
+	webhookConfig := &admissionv1.ValidatingWebhookConfiguration{}
+	webhookConfig.SetName(webhookConfigurationName)
+	if err := mcpCluster.Client().Delete(ctx, webhookConfig); client.IgnoreNotFound(err) != nil {
+		return fmt.Errorf("failed to delete validating webhook configuration %s: %w", webhookConfigurationName, err)
+	}
+
+	return nil
+}
@@ -3,6 +3,7 @@ package dns
 import (
 	"context"
 	"fmt"
+	"slices"
 	"time"
 
 	"github.com/openmcp-project/controller-utils/pkg/clusters"
@@ -21,7 +22,10 @@ const (
 	DefaultGatewayName      = "default"
 	DefaultGatewayNamespace = "openmcp-system"
 	DNSAnnotationKey        = "dns.openmcp.cloud/base-domain"
-	RequeueInterval         = 20 * time.Second
+	// InternalDNSAnnotationKey is the annotation of the gateway that contains the internal base domain.
+	// Hostnames of the internal base domain are only resolvable from clusters in the internal network of the platform.
+	InternalDNSAnnotationKey = "dns.openmcp.cloud/internal-base-domain"
+	RequeueInterval          = 20 * time.Second
 )
 
 // Reconciler is a reconciler for managing DNS records using Gateway API resources.
@@ -40,24 +44,58 @@ type Instance struct {
 	BackendName string
 	// BackendPort is the port of the backend service to which the TLSRoute will route traffic.
 	BackendPort int32
+	// AdditionalHostNames are routed by the TLSRoute in addition to the hostname derived from the gateway's base domain.
+	// This is used to keep a previous hostname reachable while migrating to a new base domain.
+	AdditionalHostNames []string
 }
 
-// GatewayReconcileResult is the result of a gateway reconciliation.
-// If Result.Requeue is not set, the gateway is ready and the HostName can be used.
-type GatewayReconcileResult struct {
+// HostNameScope distinguishes the hostnames of an instance by the gateway annotation from which their base domain is taken.
+type HostNameScope string
+
+const (
+	// HostNameScopeExternal is the scope of the hostname derived from the base domain in the DNSAnnotationKey annotation.
+	HostNameScopeExternal HostNameScope = "External"
+	// HostNameScopeInternal is the scope of the hostname derived from the base domain in the InternalDNSAnnotationKey annotation.
+	HostNameScopeInternal HostNameScope = "Internal"
+)
+
+// HostName is a hostname of an instance.
+type HostName struct {
+	// Scope is the scope of the hostname.
+	Scope HostNameScope
+	// BaseDomain is the base domain of the gateway from which the hostname is derived.
+	BaseDomain string
 	// HostName is the hostname that was created for the instance and can be used for DNS records.
 	HostName string
+}
+
+// GatewayReconcileResult is the result of a gateway reconciliation.
+// If Result.Requeue is not set, the gateway is ready and the HostNames can be used.
+type GatewayReconcileResult struct {
+	// HostNames are the hostnames of the instance, one for each base domain that is configured on the gateway.
+	// The external hostname comes first.
+	HostNames []HostName
 	// Result is the result of the reconciliation.
 	reconcile.Result
 }
 
+// ForScope returns the hostname of the given scope, if the gateway has a base domain for this scope.
+func (r *GatewayReconcileResult) ForScope(scope HostNameScope) (HostName, bool) {
+	for _, hostName := range r.HostNames {
+		if hostName.Scope == scope {
+			return hostName, true
+		}
+	}
+	return HostName{}, false
+}
+
 // NewReconciler creates a new DNS reconciler.
 func NewReconciler() *Reconciler {
 	return &Reconciler{}
 }
 
-// ReconcileGateway ensures that the default gateway exists and retrieves the base domain from its annotations.
-// It returns the full hostname for the given instance that can be used for DNS records.
+// ReconcileGateway ensures that the default gateway exists and retrieves the base domains from its annotations.
+// It returns the full hostnames for the given instance that can be used for DNS records.
 // If the default gateway is not found, it will requeue after a predefined interval.
 func (r *Reconciler) ReconcileGateway(ctx context.Context, instance *Instance, targetCluster *clusters.Cluster) (GatewayReconcileResult, error) {
 	log := logging.FromContextOrPanic(ctx)
@@ -86,22 +124,23 @@ func (r *Reconciler) ReconcileGateway(ctx context.Context, instance *Instance, t
 
 	log.Debug("Default Gateway available")
 
-	baseDomain, hasBaseDomain := getBaseDomain(gateway)
-	if !hasBaseDomain {
-		return GatewayReconcileResult{Result: reconcile.Result{}}, fmt.Errorf("gateway is missing the %s annotation", DNSAnnotationKey)
+	hostNames, err := getHostNames(gateway, instance)
+	if err != nil {
+		return GatewayReconcileResult{Result: reconcile.Result{}}, err
 	}
 
-	log.Debug("Base domain found", "baseDomain", baseDomain)
-
-	hostName := getHostName(baseDomain, instance)
+	for _, hostName := range hostNames {
+		log.Debug("Base domain found", "scope", hostName.Scope, "baseDomain", hostName.BaseDomain)
+	}
 
 	return GatewayReconcileResult{
-		HostName: hostName,
-		Result:   reconcile.Result{},
+		HostNames: hostNames,
+		Result:    reconcile.Result{},
 	}, nil
 }
 
 // ReconcileTLSRoute ensures that a TLSRoute exists for the given instance, pointing to the default gateway.
+// The TLSRoute routes the hostnames derived from the gateway's base domains and the additional hostnames of the instance.
 func (r *Reconciler) ReconcileTLSRoute(ctx context.Context, instance *Instance, targetCluster *clusters.Cluster) error {
 	// get default gateway
 
@@ -115,12 +154,22 @@ func (r *Reconciler) ReconcileTLSRoute(ctx context.Context, instance *Instance,
 		return fmt.Errorf("failed to get default gateway: %w", err)
 	}
 
-	baseDomain, hasBaseDomain := getBaseDomain(gateway)
-	if !hasBaseDomain {
-		return fmt.Errorf("gateway is missing the %s annotation", DNSAnnotationKey)
+	gatewayHostNames, err := getHostNames(gateway, instance)
+	if err != nil {
+		return err
 	}
 
-	hostName := getHostName(baseDomain, instance)
+	hostNames := []gatewayv1alpha2.Hostname{}
+	for _, hostName := range gatewayHostNames {
+		if !slices.Contains(hostNames, gatewayv1alpha2.Hostname(hostName.HostName)) {
+			hostNames = append(hostNames, gatewayv1alpha2.Hostname(hostName.HostName))
+		}
+	}
+	for _, additional := range instance.AdditionalHostNames {
+		if !slices.Contains(hostNames, gatewayv1alpha2.Hostname(additional)) {
+			hostNames = append(hostNames, gatewayv1alpha2.Hostname(additional))
+		}
+	}
 
 	tlsRoute := &gatewayv1alpha2.TLSRoute{}
 	tlsRoute.SetName(instance.Name)
@@ -136,9 +185,7 @@ func (r *Reconciler) ReconcileTLSRoute(ctx context.Context, instance *Instance,
 					},
 				},
 			},
-			Hostnames: []gatewayv1alpha2.Hostname{
-				gatewayv1alpha2.Hostname(hostName),
-			},
+			Hostnames: hostNames,
 			Rules: []gatewayv1alpha2.TLSRouteRule{
 				{
 					BackendRefs: []gatewayv1alpha2.BackendRef{
@@ -162,34 +209,6 @@ func (r *Reconciler) ReconcileTLSRoute(ctx context.Context, instance *Instance,
 	return nil
 }
 
-// IsTLSRouteReady checks if the TLSRoute for the given instance is accepted by the default gateway.
-func (r *Reconciler) IsTLSRouteReady(ctx context.Context, instance *Instance, targetCluster *clusters.Cluster) (bool, error) {
-	log := logging.FromContextOrPanic(ctx)
-
-	var err error
-
-	tlsRoute := &gatewayv1alpha2.TLSRoute{}
-	tlsRoute.SetName(instance.Name)
-	tlsRoute.SetNamespace(instance.Namespace)
-
-	if err = targetCluster.Client().Get(ctx, client.ObjectKeyFromObject(tlsRoute), tlsRoute); err != nil {
-		return false, fmt.Errorf("failed to get TLSRoute: %w", err)
-	}
-
-	for _, parent := range tlsRoute.Status.Parents {
-		if parent.ParentRef.Name == DefaultGatewayName && parent.ParentRef.Namespace != nil && *parent.ParentRef.Namespace == DefaultGatewayNamespace {
-			for _, cond := range parent.Conditions {
-				if cond.Type == string(gatewayv1alpha2.RouteConditionAccepted) && cond.Status == "True" {
-					log.Debug("TLSRoute is accepted by the gateway")
-					return true, nil
-				}
-			}
-		}
-	}
-
-	return false, nil
-}
-
 // DeleteTLSRoute deletes the TLSRoute for the given instance.
 func (r *Reconciler) DeleteTLSRoute(ctx context.Context, instance *Instance, targetCluster *clusters.Cluster) error {
 	log := logging.FromContextOrPanic(ctx)
@@ -215,14 +234,42 @@ func (r *Reconciler) DeleteTLSRoute(ctx context.Context, instance *Instance, tar
 	return nil
 }
 
-func getBaseDomain(gateway *gatewayv1.Gateway) (string, bool) {
+// getHostNames returns the hostnames of the instance for the base domains of the gateway.
+// At least one of the base domain annotations must be set.
+func getHostNames(gateway *gatewayv1.Gateway, instance *Instance) ([]HostName, error) {
+	hostNames := []HostName{}
+	for _, scope := range []HostNameScope{HostNameScopeExternal, HostNameScopeInternal} {
+		baseDomain, hasBaseDomain := getBaseDomain(gateway, scope)
+		if !hasBaseDomain {
+			continue
+		}
+		hostNames = append(hostNames, HostName{
+			Scope:      scope,
+			BaseDomain: baseDomain,
+			HostName:   getHostName(baseDomain, instance),
+		})
+	}
+
+	if len(hostNames) == 0 {
+		return nil, fmt.Errorf("gateway has neither the %s nor the %s annotation", DNSAnnotationKey, InternalDNSAnnotationKey)
+	}
+
+	return hostNames, nil
+}
+
+func getBaseDomain(gateway *gatewayv1.Gateway, scope HostNameScope) (string, bool) {
 	annotations := gateway.GetAnnotations()
 	if len(annotations) == 0 {
 		return "", false
 	}
 
-	baseDomain, hasBaseDomain := annotations[DNSAnnotationKey]
-	return baseDomain, hasBaseDomain
+	annotationKey := DNSAnnotationKey
+	if scope == HostNameScopeInternal {
+		annotationKey = InternalDNSAnnotationKey
+	}
+
+	baseDomain, hasBaseDomain := annotations[annotationKey]
+	return baseDomain, hasBaseDomain && baseDomain != ""
 }
 
 func getHostName(baseDomain string, instance *Instance) string {
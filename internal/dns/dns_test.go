@@ -0,0 +1,325 @@
+package dns_test
+
+import (
+	"github.com/openmcp-project/controller-utils/pkg/clusters"
+	testutils "github.com/openmcp-project/controller-utils/pkg/testing"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/openmcp-project/service-provider-landscaper/internal/dns"
+)
+
+func buildTestEnvironment(objects ...client.Object) *testutils.Environment {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(gatewayv1.Install(scheme))
+	utilruntime.Must(gatewayv1alpha2.Install(scheme))
+
+	return testutils.NewEnvironmentBuilder().
+		WithFakeClient(scheme).
+		WithInitObjects(objects...).
+		WithDynamicObjectsWithStatus(&gatewayv1alpha2.TLSRoute{}, &gatewayv1.Gateway{}).
+		Build()
+}
+
+// newGateway returns the default gateway with a TLS listener, which is programmed if requested.
+func newGateway(programmed bool) *gatewayv1.Gateway {
+	gateway := &gatewayv1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      dns.DefaultGatewayName,
+			Namespace: dns.DefaultGatewayNamespace,
+			Annotations: map[string]string{
+				dns.DNSAnnotationKey: "example.com",
+			},
+		},
+		Spec: gatewayv1.GatewaySpec{
+			GatewayClassName: "eg",
+			Listeners: []gatewayv1.Listener{
+				{
+					Name:     "tls",
+					Port:     9443,
+					Protocol: gatewayv1.TLSProtocolType,
+				},
+			},
+		},
+	}
+
+	status := metav1.ConditionFalse
+	if programmed {
+		status = metav1.ConditionTrue
+	}
+	gateway.Status.Listeners = []gatewayv1.ListenerStatus{
+		{
+			Name: "tls",
+			Conditions: []metav1.Condition{
+				{
+					Type:               string(gatewayv1.ListenerConditionProgrammed),
+					Status:             status,
+					Reason:             string(gatewayv1.ListenerReasonProgrammed),
+					LastTransitionTime: metav1.Now(),
+				},
+			},
+		},
+	}
+
+	return gateway
+}
+
+// setParentConditions sets the conditions that the default gateway reports for the TLSRoute.
+func setParentConditions(env *testutils.Environment, instance *dns.Instance, conditions ...metav1.Condition) {
+	tlsRoute := &gatewayv1alpha2.TLSRoute{}
+	Expect(env.Client().Get(env.Ctx, client.ObjectKey{Name: instance.Name, Namespace: instance.Namespace}, tlsRoute)).To(Succeed())
+	tlsRoute.Status.Parents = []gatewayv1alpha2.RouteParentStatus{
+		{
+			ParentRef: gatewayv1alpha2.ParentReference{
+				Name:      dns.DefaultGatewayName,
+				Namespace: ptr.To(gatewayv1.Namespace(dns.DefaultGatewayNamespace)),
+			},
+			Conditions: conditions,
+		},
+	}
+	Expect(env.Client().Status().Update(env.Ctx, tlsRoute)).To(Succeed())
+}
+
+func condition(conditionType gatewayv1.RouteConditionType, status metav1.ConditionStatus, reason gatewayv1.RouteConditionReason) metav1.Condition {
+	return metav1.Condition{
+		Type:               string(conditionType),
+		Status:             status,
+		Reason:             string(reason),
+		LastTransitionTime: metav1.Now(),
+	}
+}
+
+var _ = Describe("TLSRoute", func() {
+	var (
+		env      *testutils.Environment
+		cluster  *clusters.Cluster
+		instance *dns.Instance
+		r        *dns.Reconciler
+	)
+
+	setup := func(gateway *gatewayv1.Gateway) {
+		env = buildTestEnvironment(gateway)
+		cluster = clusters.NewTestClusterFromClient("workload", env.Client())
+		r = dns.NewReconciler()
+		Expect(r.ReconcileTLSRoute(env.Ctx, instance, cluster)).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		instance = &dns.Instance{
+			Namespace:       "route-ns",
+			Name:            "webhooks",
+			SubDomainPrefix: "webhooks",
+			BackendName:     "webhooks-svc",
+			BackendPort:     9443,
+		}
+	})
+
+	Context("CheckTLSRoute", func() {
+		It("should be ready if the route is accepted and its references are resolved", func() {
+			setup(newGateway(true))
+			setParentConditions(env, instance,
+				condition(gatewayv1.RouteConditionAccepted, metav1.ConditionTrue, gatewayv1.RouteReasonAccepted),
+				condition(gatewayv1.RouteConditionResolvedRefs, metav1.ConditionTrue, gatewayv1.RouteReasonResolvedRefs))
+
+			status, err := r.CheckTLSRoute(env.Ctx, instance, cluster)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(status.Ready).To(BeTrue())
+		})
+
+		It("should be pending as long as the gateway has not reported a status", func() {
+			setup(newGateway(true))
+
+			status, err := r.CheckTLSRoute(env.Ctx, instance, cluster)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(status.Ready).To(BeFalse())
+			Expect(status.Reason).To(Equal(dns.ReasonRoutePending))
+		})
+
+		It("should report a gateway without TLS listener", func() {
+			gateway := newGateway(true)
+			gateway.Spec.Listeners[0].Protocol = gatewayv1.HTTPSProtocolType
+			setup(gateway)
+
+			status, err := r.CheckTLSRoute(env.Ctx, instance, cluster)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(status.Reason).To(Equal(dns.ReasonNoMatchingListener))
+		})
+
+		It("should report a gateway whose TLS listener is not programmed", func() {
+			setup(newGateway(false))
+
+			status, err := r.CheckTLSRoute(env.Ctx, instance, cluster)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(status.Reason).To(Equal(dns.ReasonGatewayNotProgrammed))
+		})
+
+		It("should report a gateway without TLS listener for the hostname of the route", func() {
+			gateway := newGateway(true)
+			gateway.Spec.Listeners[0].Hostname = ptr.To(gatewayv1.Hostname("*.other.example.com"))
+			setup(gateway)
+
+			status, err := r.CheckTLSRoute(env.Ctx, instance, cluster)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(status.Reason).To(Equal(dns.ReasonNoMatchingListener))
+		})
+
+		It("should only consider the listeners to which the route attaches", func() {
+			// the programmed listener serves another domain, the listener of the route's hostname is not programmed
+			gateway := newGateway(true)
+			gateway.Spec.Listeners[0].Hostname = ptr.To(gatewayv1.Hostname("*.other.example.com"))
+			gateway.Spec.Listeners = append(gateway.Spec.Listeners, gatewayv1.Listener{
+				Name:     "tls-example",
+				Port:     9443,
+				Protocol: gatewayv1.TLSProtocolType,
+				Hostname: ptr.To(gatewayv1.Hostname("*.example.com")),
+			})
+			setup(gateway)
+			setParentConditions(env, instance,
+				condition(gatewayv1.RouteConditionAccepted, metav1.ConditionTrue, gatewayv1.RouteReasonAccepted),
+				condition(gatewayv1.RouteConditionResolvedRefs, metav1.ConditionTrue, gatewayv1.RouteReasonResolvedRefs))
+
+			status, err := r.CheckTLSRoute(env.Ctx, instance, cluster)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(status.Reason).To(Equal(dns.ReasonGatewayNotProgrammed))
+			Expect(status.Message).To(ContainSubstring("tls-example"))
+
+			// once the listener of the route's hostname is programmed, the route is ready
+			programmed := gateway.Status.Listeners[0].DeepCopy()
+			programmed.Name = "tls-example"
+			Expect(env.Client().Get(env.Ctx, client.ObjectKeyFromObject(gateway), gateway)).To(Succeed())
+			gateway.Status.Listeners = append(gateway.Status.Listeners, *programmed)
+			Expect(env.Client().Status().Update(env.Ctx, gateway)).To(Succeed())
+
+			status, err = r.CheckTLSRoute(env.Ctx, instance, cluster)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(status.Ready).To(BeTrue())
+		})
+
+		DescribeTable("should map the conditions of the route to a reason",
+			func(accepted, resolvedRefs metav1.Condition, expectedReason dns.TLSRouteNotReadyReason) {
+				setup(newGateway(true))
+				setParentConditions(env, instance, accepted, resolvedRefs)
+
+				status, err := r.CheckTLSRoute(env.Ctx, instance, cluster)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(status.Ready).To(BeFalse())
+				Expect(status.Reason).To(Equal(expectedReason))
+				Expect(status.Message).ToNot(BeEmpty())
+			},
+			Entry("not allowed by listeners",
+				condition(gatewayv1.RouteConditionAccepted, metav1.ConditionFalse, gatewayv1.RouteReasonNotAllowedByListeners),
+				condition(gatewayv1.RouteConditionResolvedRefs, metav1.ConditionTrue, gatewayv1.RouteReasonResolvedRefs),
+				dns.ReasonNoMatchingListener),
+			Entry("no matching listener hostname",
+				condition(gatewayv1.RouteConditionAccepted, metav1.ConditionFalse, gatewayv1.RouteReasonNoMatchingListenerHostname),
+				condition(gatewayv1.RouteConditionResolvedRefs, metav1.ConditionTrue, gatewayv1.RouteReasonResolvedRefs),
+				dns.ReasonNoMatchingListener),
+			Entry("not accepted for another reason",
+				condition(gatewayv1.RouteConditionAccepted, metav1.ConditionFalse, gatewayv1.RouteReasonUnsupportedValue),
+				condition(gatewayv1.RouteConditionResolvedRefs, metav1.ConditionTrue, gatewayv1.RouteReasonResolvedRefs),
+				dns.ReasonRouteNotAccepted),
+			Entry("backend not found",
+				condition(gatewayv1.RouteConditionAccepted, metav1.ConditionTrue, gatewayv1.RouteReasonAccepted),
+				condition(gatewayv1.RouteConditionResolvedRefs, metav1.ConditionFalse, gatewayv1.RouteReasonBackendNotFound),
+				dns.ReasonBackendNotFound),
+			Entry("reference not permitted",
+				condition(gatewayv1.RouteConditionAccepted, metav1.ConditionTrue, gatewayv1.RouteReasonAccepted),
+				condition(gatewayv1.RouteConditionResolvedRefs, metav1.ConditionFalse, gatewayv1.RouteReasonRefNotPermitted),
+				dns.ReasonRefNotPermitted),
+			Entry("references not resolved for another reason",
+				condition(gatewayv1.RouteConditionAccepted, metav1.ConditionTrue, gatewayv1.RouteReasonAccepted),
+				condition(gatewayv1.RouteConditionResolvedRefs, metav1.ConditionFalse, gatewayv1.RouteReasonInvalidKind),
+				dns.ReasonRefsNotResolved),
+		)
+
+		It("should be pending if the conditions belong to an older generation of the route", func() {
+			setup(newGateway(true))
+			accepted := condition(gatewayv1.RouteConditionAccepted, metav1.ConditionTrue, gatewayv1.RouteReasonAccepted)
+			resolvedRefs := condition(gatewayv1.RouteConditionResolvedRefs, metav1.ConditionTrue, gatewayv1.RouteReasonResolvedRefs)
+			accepted.ObservedGeneration = -1
+			resolvedRefs.ObservedGeneration = -1
+			setParentConditions(env, instance, accepted, resolvedRefs)
+
+			status, err := r.CheckTLSRoute(env.Ctx, instance, cluster)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(status.Reason).To(Equal(dns.ReasonRoutePending))
+		})
+	})
+
+	Context("HostNames", func() {
+		It("should derive a hostname for each base domain of the gateway", func() {
+			gateway := newGateway(true)
+			gateway.Annotations[dns.InternalDNSAnnotationKey] = "internal.example.com"
+			setup(gateway)
+
+			result, err := r.ReconcileGateway(env.Ctx, instance, cluster)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.HostNames).To(HaveLen(2))
+
+			external, ok := result.ForScope(dns.HostNameScopeExternal)
+			Expect(ok).To(BeTrue())
+			Expect(external.BaseDomain).To(Equal("example.com"))
+			Expect(external.HostName).To(HavePrefix("webhooks-"))
+			Expect(external.HostName).To(HaveSuffix(".example.com"))
+
+			internal, ok := result.ForScope(dns.HostNameScopeInternal)
+			Expect(ok).To(BeTrue())
+			Expect(internal.BaseDomain).To(Equal("internal.example.com"))
+			Expect(internal.HostName).To(HaveSuffix(".internal.example.com"))
+
+			tlsRoute := &gatewayv1alpha2.TLSRoute{}
+			Expect(env.Client().Get(env.Ctx, client.ObjectKey{Name: instance.Name, Namespace: instance.Namespace}, tlsRoute)).To(Succeed())
+			Expect(tlsRoute.Spec.Hostnames).To(ConsistOf(gatewayv1alpha2.Hostname(external.HostName), gatewayv1alpha2.Hostname(internal.HostName)))
+		})
+
+		It("should only provide the internal hostname if the gateway has only an internal base domain", func() {
+			gateway := newGateway(true)
+			gateway.Annotations = map[string]string{dns.InternalDNSAnnotationKey: "internal.example.com"}
+			setup(gateway)
+
+			result, err := r.ReconcileGateway(env.Ctx, instance, cluster)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.HostNames).To(HaveLen(1))
+			_, ok := result.ForScope(dns.HostNameScopeExternal)
+			Expect(ok).To(BeFalse())
+		})
+
+		It("should fail if the gateway has no base domain", func() {
+			gateway := newGateway(true)
+			gateway.Annotations = nil
+			env = buildTestEnvironment(gateway)
+			cluster = clusters.NewTestClusterFromClient("workload", env.Client())
+			r = dns.NewReconciler()
+
+			_, err := r.ReconcileGateway(env.Ctx, instance, cluster)
+			Expect(err).To(MatchError(ContainSubstring(dns.DNSAnnotationKey)))
+			Expect(r.ReconcileTLSRoute(env.Ctx, instance, cluster)).ToNot(Succeed())
+		})
+	})
+
+	Context("Backend", func() {
+		It("should reference the backend in the namespace of the route", func() {
+			setup(newGateway(true))
+
+			tlsRoute := &gatewayv1alpha2.TLSRoute{}
+			Expect(env.Client().Get(env.Ctx, client.ObjectKey{Name: instance.Name, Namespace: instance.Namespace}, tlsRoute)).To(Succeed())
+			Expect(tlsRoute.Spec.Rules[0].BackendRefs[0].Name).To(Equal(gatewayv1.ObjectName("webhooks-svc")))
+			Expect(tlsRoute.Spec.Rules[0].BackendRefs[0].Namespace).To(BeNil())
+
+			Expect(r.DeleteTLSRoute(env.Ctx, instance, cluster)).To(Succeed())
+			err := env.Client().Get(env.Ctx, client.ObjectKeyFromObject(tlsRoute), tlsRoute)
+			Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		})
+	})
+})
@@ -0,0 +1,66 @@
+package dns
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net"
+	"time"
+)
+
+const (
+	DefaultProbeTimeout = 10 * time.Second
+)
+
+// WebhookProbe checks whether a webhook server is reachable under its published hostname.
+type WebhookProbe interface {
+	// Probe performs a TLS handshake with the server at the given address.
+	// It succeeds if the served certificate is valid for the hostName and signed by a certificate of the caBundle.
+	Probe(ctx context.Context, address, hostName string, caBundle []byte) error
+}
+
+// TLSWebhookProbe is a WebhookProbe that connects directly to the webhook server.
+type TLSWebhookProbe struct {
+	// Timeout is the maximum duration of the connection attempt including the TLS handshake.
+	Timeout time.Duration
+}
+
+var _ WebhookProbe = &TLSWebhookProbe{}
+
+// NewTLSWebhookProbe creates a new TLSWebhookProbe with the default timeout.
+func NewTLSWebhookProbe() *TLSWebhookProbe {
+	return &TLSWebhookProbe{
+		Timeout: DefaultProbeTimeout,
+	}
+}
+
+func (p *TLSWebhookProbe) Probe(ctx context.Context, address, hostName string, caBundle []byte) error {
+	rootCAs := x509.NewCertPool()
+	if !rootCAs.AppendCertsFromPEM(caBundle) {
+		return fmt.Errorf("CA bundle does not contain any valid certificate")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, p.Timeout)
+	defer cancel()
+
+	dialer := &tls.Dialer{
+		Config: &tls.Config{
+			RootCAs:    rootCAs,
+			ServerName: hostName,
+			MinVersion: tls.VersionTLS12,
+		},
+	}
+
+	conn, err := dialer.DialContext(ctx, "tcp", address)
+	if err != nil {
+		return fmt.Errorf("TLS handshake with %s failed: %w", address, err)
+	}
+
+	return conn.Close()
+}
+
+// WebhookAddress returns the address under which a webhook server with the given hostname and port is reachable.
+func WebhookAddress(hostName string, port int32) string {
+	return net.JoinHostPort(hostName, fmt.Sprint(port))
+}
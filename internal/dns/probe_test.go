@@ -0,0 +1,102 @@
+package dns_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/openmcp-project/service-provider-landscaper/internal/dns"
+)
+
+func TestDNS(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "DNS Test Suite")
+}
+
+// caBundleOf returns the PEM encoded certificate of the test server, which is self-signed and therefore its own CA.
+func caBundleOf(server *httptest.Server) []byte {
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+}
+
+// newCABundle returns the PEM encoded certificate of a new self-signed CA.
+func newCABundle() []byte {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	Expect(err).ToNot(HaveOccurred())
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test-ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	Expect(err).ToNot(HaveOccurred())
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+}
+
+var _ = Describe("TLS Webhook Probe", func() {
+	// the certificate of the test server is valid for example.com
+	const hostName = "example.com"
+
+	var (
+		server  *httptest.Server
+		address string
+		probe   *dns.TLSWebhookProbe
+	)
+
+	BeforeEach(func() {
+		server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {}))
+		DeferCleanup(server.Close)
+
+		serverURL, err := url.Parse(server.URL)
+		Expect(err).ToNot(HaveOccurred())
+		address = serverURL.Host
+
+		probe = &dns.TLSWebhookProbe{Timeout: 5 * time.Second}
+	})
+
+	It("should succeed if the served certificate is signed by the CA bundle", func() {
+		Expect(probe.Probe(context.Background(), address, hostName, caBundleOf(server))).To(Succeed())
+	})
+
+	It("should fail if the served certificate is not signed by the CA bundle", func() {
+		Expect(probe.Probe(context.Background(), address, hostName, newCABundle())).To(MatchError(ContainSubstring("TLS handshake")))
+	})
+
+	It("should fail if the CA bundle contains no certificate", func() {
+		Expect(probe.Probe(context.Background(), address, hostName, []byte("invalid"))).To(MatchError(ContainSubstring("CA bundle")))
+	})
+
+	It("should fail if the served certificate is not valid for the hostname", func() {
+		Expect(probe.Probe(context.Background(), address, "webhooks.other.domain", caBundleOf(server))).
+			To(MatchError(ContainSubstring("TLS handshake")))
+	})
+
+	It("should fail if the webhook server is not reachable", func() {
+		caBundle := caBundleOf(server)
+		server.Close()
+
+		Expect(probe.Probe(context.Background(), address, hostName, caBundle)).To(MatchError(ContainSubstring("TLS handshake")))
+	})
+
+	It("should build the address from hostname and port", func() {
+		Expect(dns.WebhookAddress("webhooks.example.com", 9443)).To(Equal("webhooks.example.com:9443"))
+	})
+})
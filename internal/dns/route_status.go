@@ -0,0 +1,219 @@
+package dns
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/openmcp-project/controller-utils/pkg/clusters"
+	"github.com/openmcp-project/controller-utils/pkg/logging"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gatewayv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+)
+
+// TLSRouteNotReadyReason describes why a TLSRoute does not route traffic to its backend.
+type TLSRouteNotReadyReason string
+
+const (
+	// ReasonRoutePending means that the gateway has not yet processed the current generation of the TLSRoute.
+	ReasonRoutePending TLSRouteNotReadyReason = "RoutePending"
+	// ReasonNoMatchingListener means that the gateway has no TLS listener to which the TLSRoute can attach for one of its hostnames.
+	ReasonNoMatchingListener TLSRouteNotReadyReason = "NoMatchingListener"
+	// ReasonGatewayNotProgrammed means that the TLS listeners to which the TLSRoute attaches are not programmed.
+	ReasonGatewayNotProgrammed TLSRouteNotReadyReason = "GatewayNotProgrammed"
+	// ReasonRouteNotAccepted means that the gateway has rejected the TLSRoute for another reason.
+	ReasonRouteNotAccepted TLSRouteNotReadyReason = "RouteNotAccepted"
+	// ReasonBackendNotFound means that the backend service of the TLSRoute does not exist.
+	ReasonBackendNotFound TLSRouteNotReadyReason = "BackendNotFound"
+	// ReasonRefNotPermitted means that the reference to the backend service is not permitted by a ReferenceGrant.
+	ReasonRefNotPermitted TLSRouteNotReadyReason = "RefNotPermitted"
+	// ReasonRefsNotResolved means that the backend references of the TLSRoute could not be resolved for another reason.
+	ReasonRefsNotResolved TLSRouteNotReadyReason = "BackendRefsNotResolved"
+)
+
+// TLSRouteStatus is the result of a TLSRoute check.
+// If Ready is false, Reason and Message describe why the TLSRoute does not route traffic.
+type TLSRouteStatus struct {
+	Ready   bool
+	Reason  TLSRouteNotReadyReason
+	Message string
+}
+
+func tlsRouteNotReady(reason TLSRouteNotReadyReason, format string, args ...any) TLSRouteStatus {
+	return TLSRouteStatus{
+		Reason:  reason,
+		Message: fmt.Sprintf(format, args...),
+	}
+}
+
+// CheckTLSRoute checks if the TLSRoute for the given instance routes traffic to its backend.
+// This is the case if the TLS listeners of the default gateway to which the TLSRoute attaches are programmed,
+// and the TLSRoute is accepted by the gateway and its backend references are resolved.
+func (r *Reconciler) CheckTLSRoute(ctx context.Context, instance *Instance, targetCluster *clusters.Cluster) (TLSRouteStatus, error) {
+	log := logging.FromContextOrPanic(ctx)
+
+	gateway := &gatewayv1.Gateway{}
+	gateway.SetName(DefaultGatewayName)
+	gateway.SetNamespace(DefaultGatewayNamespace)
+
+	if err := targetCluster.Client().Get(ctx, client.ObjectKeyFromObject(gateway), gateway); err != nil {
+		return TLSRouteStatus{}, fmt.Errorf("failed to get default gateway: %w", err)
+	}
+
+	tlsRoute := &gatewayv1alpha2.TLSRoute{}
+	tlsRoute.SetName(instance.Name)
+	tlsRoute.SetNamespace(instance.Namespace)
+
+	if err := targetCluster.Client().Get(ctx, client.ObjectKeyFromObject(tlsRoute), tlsRoute); err != nil {
+		return TLSRouteStatus{}, fmt.Errorf("failed to get TLSRoute: %w", err)
+	}
+
+	if status := checkGatewayListeners(gateway, tlsRoute); !status.Ready {
+		return status, nil
+	}
+
+	status := checkTLSRouteParentStatus(tlsRoute)
+	if status.Ready {
+		log.Debug("TLSRoute is accepted by the gateway and its references are resolved")
+	}
+
+	return status, nil
+}
+
+// checkGatewayListeners checks that each hostname of the TLSRoute is served by a programmed TLS listener of the gateway.
+// The TLSRoute attaches to the TLS listeners which its parent reference selects by section name and port,
+// and whose hostname matches a hostname of the TLSRoute.
+func checkGatewayListeners(gateway *gatewayv1.Gateway, tlsRoute *gatewayv1alpha2.TLSRoute) TLSRouteStatus {
+	programmed := map[gatewayv1.SectionName]bool{}
+	for _, listener := range gateway.Status.Listeners {
+		programmed[listener.Name] = apimeta.IsStatusConditionTrue(listener.Conditions, string(gatewayv1.ListenerConditionProgrammed))
+	}
+
+	parentRef := defaultGatewayParentRef(tlsRoute)
+
+	hostNames := tlsRoute.Spec.Hostnames
+	if len(hostNames) == 0 {
+		// a TLSRoute without hostnames attaches to the listeners of all hostnames
+		hostNames = []gatewayv1alpha2.Hostname{""}
+	}
+
+	for _, hostName := range hostNames {
+		listeners := attachedListeners(gateway, parentRef, hostName)
+		if len(listeners) == 0 {
+			return tlsRouteNotReady(ReasonNoMatchingListener, "gateway %s/%s has no TLS listener for hostname %q", gateway.Namespace, gateway.Name, hostName)
+		}
+		if !slices.ContainsFunc(listeners, func(name string) bool { return programmed[gatewayv1.SectionName(name)] }) {
+			return tlsRouteNotReady(ReasonGatewayNotProgrammed, "TLS listener %s of gateway %s/%s for hostname %q is not programmed",
+				strings.Join(listeners, ", "), gateway.Namespace, gateway.Name, hostName)
+		}
+	}
+
+	return TLSRouteStatus{Ready: true}
+}
+
+// attachedListeners returns the names of the TLS listeners of the gateway to which the TLSRoute attaches for the given hostname.
+func attachedListeners(gateway *gatewayv1.Gateway, parentRef *gatewayv1alpha2.ParentReference, hostName gatewayv1alpha2.Hostname) []string {
+	var listeners []string
+	for _, listener := range gateway.Spec.Listeners {
+		if listener.Protocol != gatewayv1.TLSProtocolType {
+			continue
+		}
+		if parentRef != nil && parentRef.SectionName != nil && *parentRef.SectionName != listener.Name {
+			continue
+		}
+		if parentRef != nil && parentRef.Port != nil && *parentRef.Port != listener.Port {
+			continue
+		}
+		if !hostNameMatches(listener.Hostname, hostName) {
+			continue
+		}
+		listeners = append(listeners, string(listener.Name))
+	}
+	return listeners
+}
+
+// hostNameMatches checks whether the hostname of a listener matches the hostname of a route.
+// A listener without hostname matches all hostnames, and a wildcard hostname matches all subdomains of its domain.
+func hostNameMatches(listenerHostName *gatewayv1.Hostname, routeHostName gatewayv1alpha2.Hostname) bool {
+	if listenerHostName == nil || *listenerHostName == "" || routeHostName == "" {
+		return true
+	}
+	listener, route := string(*listenerHostName), string(routeHostName)
+	if listener == route {
+		return true
+	}
+	if suffix, ok := strings.CutPrefix(listener, "*"); ok {
+		return strings.HasSuffix(route, suffix) && len(route) > len(suffix)
+	}
+	if suffix, ok := strings.CutPrefix(route, "*"); ok {
+		return strings.HasSuffix(listener, suffix) && len(listener) > len(suffix)
+	}
+	return false
+}
+
+// defaultGatewayParentRef returns the parent reference of the TLSRoute to the default gateway.
+func defaultGatewayParentRef(tlsRoute *gatewayv1alpha2.TLSRoute) *gatewayv1alpha2.ParentReference {
+	for i, parentRef := range tlsRoute.Spec.ParentRefs {
+		if isDefaultGateway(parentRef) {
+			return &tlsRoute.Spec.ParentRefs[i]
+		}
+	}
+	return nil
+}
+
+// isDefaultGateway checks whether the parent reference points to the default gateway.
+func isDefaultGateway(parentRef gatewayv1alpha2.ParentReference) bool {
+	return parentRef.Name == DefaultGatewayName && parentRef.Namespace != nil && *parentRef.Namespace == DefaultGatewayNamespace
+}
+
+// checkTLSRouteParentStatus evaluates the Accepted and ResolvedRefs conditions that the default gateway has reported for the TLSRoute.
+func checkTLSRouteParentStatus(tlsRoute *gatewayv1alpha2.TLSRoute) TLSRouteStatus {
+	for _, parent := range tlsRoute.Status.Parents {
+		if !isDefaultGateway(parent.ParentRef) {
+			continue
+		}
+
+		accepted := apimeta.FindStatusCondition(parent.Conditions, string(gatewayv1.RouteConditionAccepted))
+		if isStale(accepted, tlsRoute.Generation) {
+			return tlsRouteNotReady(ReasonRoutePending, "TLSRoute has not yet been accepted by the gateway")
+		}
+		if accepted.Status != metav1.ConditionTrue {
+			switch gatewayv1.RouteConditionReason(accepted.Reason) {
+			case gatewayv1.RouteReasonNotAllowedByListeners, gatewayv1.RouteReasonNoMatchingListenerHostname, gatewayv1.RouteReasonNoMatchingParent:
+				return tlsRouteNotReady(ReasonNoMatchingListener, "TLSRoute is not accepted by any listener of the gateway: %s", accepted.Message)
+			case gatewayv1.RouteReasonPending:
+				return tlsRouteNotReady(ReasonRoutePending, "TLSRoute has not yet been accepted by the gateway: %s", accepted.Message)
+			default:
+				return tlsRouteNotReady(ReasonRouteNotAccepted, "TLSRoute is not accepted by the gateway (%s): %s", accepted.Reason, accepted.Message)
+			}
+		}
+
+		resolvedRefs := apimeta.FindStatusCondition(parent.Conditions, string(gatewayv1.RouteConditionResolvedRefs))
+		if isStale(resolvedRefs, tlsRoute.Generation) {
+			return tlsRouteNotReady(ReasonRoutePending, "backend references of the TLSRoute have not yet been resolved")
+		}
+		if resolvedRefs.Status != metav1.ConditionTrue {
+			switch gatewayv1.RouteConditionReason(resolvedRefs.Reason) {
+			case gatewayv1.RouteReasonBackendNotFound:
+				return tlsRouteNotReady(ReasonBackendNotFound, "backend of the TLSRoute not found: %s", resolvedRefs.Message)
+			case gatewayv1.RouteReasonRefNotPermitted:
+				return tlsRouteNotReady(ReasonRefNotPermitted, "backend reference of the TLSRoute is not permitted by a ReferenceGrant: %s", resolvedRefs.Message)
+			default:
+				return tlsRouteNotReady(ReasonRefsNotResolved, "backend references of the TLSRoute are not resolved (%s): %s", resolvedRefs.Reason, resolvedRefs.Message)
+			}
+		}
+
+		return TLSRouteStatus{Ready: true}
+	}
+
+	return tlsRouteNotReady(ReasonRoutePending, "gateway has not yet reported a status for the TLSRoute")
+}
+
+// isStale returns true if the condition is missing or has been observed for an older generation.
+func isStale(condition *metav1.Condition, generation int64) bool {
+	return condition == nil || condition.ObservedGeneration < generation
+}
@@ -0,0 +1,111 @@
+package containerdeployer_test
+
+import (
+	"testing"
+
+	"github.com/openmcp-project/service-provider-landscaper/internal/installer/containerdeployer"
+	"github.com/openmcp-project/service-provider-landscaper/internal/installer/rbac"
+
+	"github.com/openmcp-project/controller-utils/pkg/clusters"
+	testutils "github.com/openmcp-project/controller-utils/pkg/testing"
+	clustersv1alpha1 "github.com/openmcp-project/openmcp-operator/api/clusters/v1alpha1"
+	deploymentv1alpha1 "github.com/openmcp-project/openmcp-operator/api/provider/v1alpha1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lsv1alpha2 "github.com/openmcp-project/service-provider-landscaper/api/v1alpha2"
+)
+
+const (
+	version = "v0.135.0"
+)
+
+func TestConfig(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Container Deployer Installer Test Suite")
+}
+
+func buildTestEnvironment(testdataDir string, objectsWithStatus ...client.Object) *testutils.Environment {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(clustersv1alpha1.AddToScheme(scheme))
+	utilruntime.Must(deploymentv1alpha1.AddToScheme(scheme))
+	utilruntime.Must(lsv1alpha2.AddToScheme(scheme))
+
+	return testutils.NewEnvironmentBuilder().
+		WithFakeClient(scheme).
+		WithInitObjectPath("testdata", testdataDir).
+		WithInitObjects(objectsWithStatus...).
+		Build()
+}
+
+var _ = Describe("Container Deployer Installer", func() {
+	const instanceID = "test-g23tp"
+
+	It("should install the container deployer", func() {
+		env := buildTestEnvironment("test-01")
+
+		workloadCluster := clusters.NewTestClusterFromClient("workload", env.Client())
+		mcpCluster := clusters.NewTestClusterFromClient("mcp", env.Client())
+
+		kubeconfig, err := rbac.TestKubeconfigAccessorImpl(env.Ctx, mcpCluster)
+		Expect(err).ToNot(HaveOccurred())
+
+		providerConfig := lsv1alpha2.ProviderConfig{}
+		Expect(env.Client().Get(env.Ctx, client.ObjectKey{Name: "default"}, &providerConfig)).To(Succeed())
+
+		offering := providerConfig.Spec.Deployment.GetDeployerOffering(lsv1alpha2.DeployerContainer)
+		Expect(offering).ToNot(BeNil())
+
+		values := &containerdeployer.Values{
+			Instance:                  instanceID,
+			Version:                   version,
+			WorkloadCluster:           workloadCluster,
+			MCPClusterKubeconfig:      string(kubeconfig),
+			WorkloadClusterKubeconfig: string(kubeconfig),
+			Image: lsv1alpha2.ImageConfiguration{
+				Image: providerConfig.GetDeployerImageLocation(offering, version),
+			},
+			InitImage:              providerConfig.GetContainerDeployerInitImageLocation(offering, version),
+			WaitImage:              providerConfig.GetContainerDeployerWaitImageLocation(offering, version),
+			PodSecurityContext:     nil,
+			SecurityContext:        nil,
+			WorkloadClientSettings: nil,
+			MCPClientSettings:      nil,
+		}
+
+		Expect(values.Image.Image).To(Equal("registry.test/components/" + lsv1alpha2.ContainerDeployerImageLocation + ":" + version))
+		Expect(values.InitImage).To(Equal("registry.test/components/" + lsv1alpha2.ContainerDeployerInitImageLocation + ":" + version))
+		Expect(values.WaitImage).To(Equal("other.registry.test/landscaper/images/container-deployer-wait:" + version))
+
+		exports, err := containerdeployer.InstallContainerDeployer(env.Ctx, values)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(exports.DeploymentName).To(Equal("container-deployer"))
+		Expect(values.Configuration.Namespace).To(Equal("ls-system-" + instanceID))
+	})
+
+	It("should uninstall the container deployer", func() {
+		env := buildTestEnvironment("test-01")
+
+		workloadCluster := clusters.NewTestClusterFromClient("workload", env.Client())
+		mcpCluster := clusters.NewTestClusterFromClient("mcp", env.Client())
+
+		kubeconfig, err := rbac.TestKubeconfigAccessorImpl(env.Ctx, mcpCluster)
+		Expect(err).ToNot(HaveOccurred())
+
+		values := &containerdeployer.Values{
+			Instance:             instanceID,
+			WorkloadCluster:      workloadCluster,
+			MCPClusterKubeconfig: string(kubeconfig),
+		}
+
+		Expect(containerdeployer.UninstallContainerDeployer(env.Ctx, values)).ToNot(HaveOccurred())
+	})
+
+})
@@ -0,0 +1,26 @@
+package containerdeployer
+
+import (
+	"github.com/openmcp-project/controller-utils/pkg/resources"
+	v1 "k8s.io/api/core/v1"
+)
+
+func newMCPKubeconfigSecretMutator(b *valuesHelper) resources.Mutator[*v1.Secret] {
+	m := resources.NewSecretMutator(
+		b.mcpKubeconfigSecretName(),
+		b.workloadNamespace(),
+		b.mcpKubeconfig.Data(),
+		v1.SecretTypeOpaque)
+	m.MetadataMutator().WithLabels(b.containerDeployerComponent.Labels())
+	return m
+}
+
+func newWorkloadKubeconfigSecretMutator(b *valuesHelper) resources.Mutator[*v1.Secret] {
+	m := resources.NewSecretMutator(
+		b.workloadKubeconfigSecretName(),
+		b.workloadNamespace(),
+		b.workloadKubeconfig.Data(),
+		v1.SecretTypeOpaque)
+	m.MetadataMutator().WithLabels(b.containerDeployerComponent.Labels())
+	return m
+}
@@ -0,0 +1,121 @@
+package containerdeployer
+
+import (
+	"fmt"
+
+	"github.com/openmcp-project/controller-utils/pkg/clusters"
+	"github.com/openmcp-project/landscaper/apis/deployer/container/v1alpha1"
+	core "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/ptr"
+
+	api "github.com/openmcp-project/service-provider-landscaper/api/v1alpha2"
+	"github.com/openmcp-project/service-provider-landscaper/internal/shared/identity"
+	"github.com/openmcp-project/service-provider-landscaper/internal/shared/sources"
+	"github.com/openmcp-project/service-provider-landscaper/internal/shared/types"
+)
+
+type Values struct {
+	Instance                  identity.Instance `json:"instance,omitempty"`
+	Version                   string            `json:"version,omitempty"`
+	Sources                   *sources.Resolver
+	WorkloadCluster           *clusters.Cluster
+	VerbosityLevel            string                       `json:"verbosityLevel,omitempty"`
+	MCPClusterKubeconfig      string                       `json:"mcpClusterKubeconfig,omitempty"`
+	WorkloadClusterKubeconfig string                       `json:"workloadClusterKubeconfig,omitempty"`
+	Image                     api.ImageConfiguration       `json:"image,omitempty"`
+	InitImage                 string                       `json:"initImage,omitempty"`
+	WaitImage                 string                       `json:"waitImage,omitempty"`
+	ReplicaCount              *int32                       `json:"replicaCount,omitempty"`
+	Resources                 core.ResourceRequirements    `json:"resources,omitempty"`
+	PodSecurityContext        *core.PodSecurityContext     `json:"podSecurityContext,omitempty"`
+	SecurityContext           *core.SecurityContext        `json:"securityContext,omitempty"`
+	Scheduling                *types.SchedulingValues      `json:"scheduling,omitempty"`
+	VerticalScaling           *types.VerticalScalingValues `json:"verticalScaling,omitempty"`
+	Configuration             v1alpha1.Configuration       `json:"configuration,omitempty"`
+	WorkloadClientSettings    *ClientSettings              `json:"workloadClientSettings,omitempty"`
+	MCPClientSettings         *ClientSettings              `json:"mcpClientSettings,omitempty"`
+	HPA                       types.HPAValues              `json:"hpa,omitempty"`
+	CAConfigMap               *core.ConfigMapKeySelector   `json:"caConfigMap,omitempty"`
+}
+
+type ClientSettings struct {
+	Burst int32 `json:"burst,omitempty"`
+	QPS   int32 `json:"qps,omitempty"`
+}
+
+func (v *Values) Default() error {
+	if v.VerbosityLevel == "" {
+		v.VerbosityLevel = "info"
+	}
+	if v.ReplicaCount == nil {
+		v.ReplicaCount = ptr.To(int32(1))
+	}
+	if v.Configuration.APIVersion == "" {
+		v.Configuration.APIVersion = "container.deployer.landscaper.gardener.cloud/v1alpha1"
+	}
+	if v.Configuration.Kind == "" {
+		v.Configuration.Kind = "Configuration"
+	}
+	if v.Configuration.Identity == "" {
+		v.Configuration.Identity = fmt.Sprintf("container-deployer-%s", v.Instance)
+	}
+	if v.Configuration.Namespace == "" {
+		// the pods of the deploy items run in the instance namespace on the workload cluster
+		v.Configuration.Namespace = v.Instance.Namespace()
+	}
+	if v.Configuration.InitContainer.Image == "" {
+		v.Configuration.InitContainer.Image = v.InitImage
+	}
+	if v.Configuration.WaitContainer.Image == "" {
+		v.Configuration.WaitContainer.Image = v.WaitImage
+	}
+	if v.Configuration.GarbageCollection.Worker == 0 {
+		v.Configuration.GarbageCollection.Worker = 5
+	}
+	if v.Configuration.Controller.Workers == 0 {
+		v.Configuration.Controller.Workers = 10
+	}
+	if v.WorkloadClientSettings == nil {
+		v.WorkloadClientSettings = &ClientSettings{}
+	}
+	if v.WorkloadClientSettings.Burst == 0 {
+		v.WorkloadClientSettings.Burst = 30
+	}
+	if v.WorkloadClientSettings.QPS == 0 {
+		v.WorkloadClientSettings.QPS = 20
+	}
+	if v.MCPClientSettings == nil {
+		v.MCPClientSettings = &ClientSettings{}
+	}
+	if v.MCPClientSettings.Burst == 0 {
+		v.MCPClientSettings.Burst = 60
+	}
+	if v.MCPClientSettings.QPS == 0 {
+		v.MCPClientSettings.QPS = 40
+	}
+	if v.Resources.Requests == nil {
+		cpu, err := resource.ParseQuantity("100m")
+		if err != nil {
+			return err
+		}
+		memory, err := resource.ParseQuantity("100Mi")
+		if err != nil {
+			return err
+		}
+		v.Resources.Requests = core.ResourceList{
+			core.ResourceCPU:    cpu,
+			core.ResourceMemory: memory,
+		}
+	}
+	v.HPA.Default(1, 1)
+
+	if v.PodSecurityContext == nil {
+		v.PodSecurityContext = types.DefaultPodSecurityContext()
+	}
+	if v.SecurityContext == nil {
+		v.SecurityContext = types.DefaultSecurityContext()
+	}
+
+	return nil
+}
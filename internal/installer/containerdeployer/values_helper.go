@@ -0,0 +1,94 @@
+package containerdeployer
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/openmcp-project/service-provider-landscaper/internal/shared/identity"
+	"github.com/openmcp-project/service-provider-landscaper/internal/shared/kubeconfigs"
+)
+
+const (
+	componentContainerDeployer = "container-deployer"
+)
+
+type valuesHelper struct {
+	values *Values
+
+	containerDeployerComponent *identity.Component
+
+	configYaml []byte
+	configHash string
+
+	mcpKubeconfig      *kubeconfigs.Secret
+	workloadKubeconfig *kubeconfigs.Secret
+}
+
+func newValuesHelper(values *Values) (*valuesHelper, error) {
+	if values == nil {
+		return nil, fmt.Errorf("values must not be nil")
+	}
+	if err := values.Default(); err != nil {
+		return nil, fmt.Errorf("failed to apply default container deployer values: %w", err)
+	}
+
+	// compute values
+	configYaml, err := yaml.Marshal(values.Configuration)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal container deployer config: %w", err)
+	}
+	hash := sha256.Sum256(configYaml)
+	configHash := hex.EncodeToString(hash[:])
+
+	h := &valuesHelper{
+		values:                     values,
+		containerDeployerComponent: identity.NewComponent(values.Instance, values.Version, componentContainerDeployer),
+		configYaml:                 configYaml,
+		configHash:                 configHash,
+	}
+
+	if h.mcpKubeconfig, err = kubeconfigs.NewSecret(h.mcpClusterKubeconfig(), h.mcpKubeconfigSecretName()); err != nil {
+		return nil, err
+	}
+	if h.workloadKubeconfig, err = kubeconfigs.NewSecret(h.workloadClusterKubeconfig(), h.workloadKubeconfigSecretName()); err != nil {
+		return nil, err
+	}
+	return h, nil
+}
+
+func newValuesHelperForDelete(values *Values) (*valuesHelper, error) {
+	if values == nil {
+		return nil, fmt.Errorf("values must not be nil")
+	}
+	if err := values.Default(); err != nil {
+		return nil, fmt.Errorf("failed to apply default container deployer values during delete operation: %w", err)
+	}
+
+	return &valuesHelper{
+		values:                     values,
+		containerDeployerComponent: identity.NewComponent(values.Instance, values.Version, componentContainerDeployer),
+	}, nil
+}
+
+func (h *valuesHelper) workloadNamespace() string {
+	return h.values.Instance.Namespace()
+}
+
+func (h *valuesHelper) mcpKubeconfigSecretName() string {
+	return h.containerDeployerComponent.NamespacedResourceName("mcp-kubeconfig")
+}
+
+func (h *valuesHelper) mcpClusterKubeconfig() []byte {
+	return []byte(h.values.MCPClusterKubeconfig)
+}
+
+func (h *valuesHelper) workloadKubeconfigSecretName() string {
+	return h.containerDeployerComponent.NamespacedResourceName("workload-kubeconfig")
+}
+
+func (h *valuesHelper) workloadClusterKubeconfig() []byte {
+	return []byte(h.values.WorkloadClusterKubeconfig)
+}
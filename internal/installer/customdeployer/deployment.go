@@ -0,0 +1,222 @@
+package customdeployer
+
+import (
+	"fmt"
+
+	"k8s.io/utils/ptr"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/openmcp-project/controller-utils/pkg/resources"
+
+	configmapsync "github.com/openmcp-project/service-provider-landscaper/internal/shared/configmaps"
+	"github.com/openmcp-project/service-provider-landscaper/internal/shared/kubeconfigs"
+	"github.com/openmcp-project/service-provider-landscaper/internal/shared/types"
+)
+
+type deploymentMutator struct {
+	*valuesHelper
+	metadata         resources.MetadataMutator
+	imagePullSecrets []corev1.LocalObjectReference
+}
+
+var _ resources.Mutator[*appsv1.Deployment] = &deploymentMutator{}
+
+func newDeploymentMutator(b *valuesHelper) *deploymentMutator {
+	return &deploymentMutator{valuesHelper: b, metadata: resources.NewMetadataMutator()}
+}
+
+func (d *deploymentMutator) WithImagePullSecrets(imagePullSecrets []corev1.LocalObjectReference) *deploymentMutator {
+	d.imagePullSecrets = imagePullSecrets
+	return d
+}
+
+func (d *deploymentMutator) Convert() resources.Mutator[*appsv1.Deployment] {
+	return d
+}
+
+func (d *deploymentMutator) String() string {
+	return fmt.Sprintf("deployment %s/%s", d.workloadNamespace(), d.customDeployerComponent.NamespacedDefaultResourceName())
+}
+
+func (d *deploymentMutator) Empty() *appsv1.Deployment {
+	return &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      d.customDeployerComponent.NamespacedDefaultResourceName(),
+			Namespace: d.workloadNamespace(),
+		},
+	}
+}
+
+func (d *deploymentMutator) MetadataMutator() resources.MetadataMutator {
+	return d.metadata
+}
+
+func (d *deploymentMutator) Mutate(r *appsv1.Deployment) error {
+	r.Labels = d.customDeployerComponent.Labels()
+	r.Spec = appsv1.DeploymentSpec{
+		Replicas: d.values.ReplicaCount,
+		Selector: &metav1.LabelSelector{MatchLabels: d.customDeployerComponent.SelectorLabels()},
+		Strategy: d.strategy(),
+		Template: corev1.PodTemplateSpec{
+			ObjectMeta: metav1.ObjectMeta{
+				Labels:      d.customDeployerComponent.DeploymentTemplateLabels(),
+				Annotations: d.templateAnnotations(),
+			},
+			Spec: corev1.PodSpec{
+				AutomountServiceAccountToken: ptr.To(false),
+				Volumes:                      d.volumes(),
+				Containers:                   d.containers(),
+				SecurityContext:              d.values.PodSecurityContext,
+				ImagePullSecrets:             d.imagePullSecrets,
+				TopologySpreadConstraints:    d.customDeployerComponent.TopologySpreadConstraints(),
+			},
+		},
+	}
+	d.values.Scheduling.ApplyTo(&r.Spec.Template.Spec, d.customDeployerComponent.TopologyLabels())
+	return nil
+}
+
+func (d *deploymentMutator) strategy() appsv1.DeploymentStrategy {
+	strategy := appsv1.DeploymentStrategy{}
+	if d.values.HPA.MaxReplicas == 1 {
+		strategy.Type = appsv1.RecreateDeploymentStrategyType
+	}
+	return strategy
+}
+
+func (d *deploymentMutator) templateAnnotations() map[string]string {
+	annotations := map[string]string{
+		"checksum/config":        d.configHash,
+		"checksum/mcpKubeconfig": d.mcpKubeconfig.Hash(),
+	}
+	return annotations
+}
+
+func (d *deploymentMutator) containers() []corev1.Container {
+	c := corev1.Container{}
+	c.Name = d.customDeployerComponent.NamespacedDefaultResourceName()
+	c.Image = d.values.Image.Image
+	c.Args = d.args()
+	c.Env = d.env()
+	c.Resources = d.values.Resources
+	c.VolumeMounts = d.volumeMounts()
+	c.ImagePullPolicy = corev1.PullIfNotPresent
+	c.SecurityContext = d.values.SecurityContext
+	return []corev1.Container{c}
+}
+
+func (d *deploymentMutator) volumes() []corev1.Volume {
+	volumes := []corev1.Volume{
+		{
+			Name: "config",
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{
+					SecretName: fmt.Sprintf("%s-config", d.customDeployerComponent.NamespacedDefaultResourceName()),
+				},
+			},
+		},
+		{
+			Name: d.mcpKubeconfigSecretName(),
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{
+					SecretName: d.mcpKubeconfigSecretName(),
+				},
+			},
+		},
+	}
+
+	if d.values.CAConfigMap != nil {
+		caVolume := corev1.Volume{
+			Name: configmapsync.CustomCaVolumeName,
+			VolumeSource: corev1.VolumeSource{
+				ConfigMap: &corev1.ConfigMapVolumeSource{
+					LocalObjectReference: d.values.CAConfigMap.LocalObjectReference,
+					Items: []corev1.KeyToPath{
+						{
+							Key:  d.values.CAConfigMap.Key,
+							Path: d.values.CAConfigMap.Key,
+						},
+					},
+				},
+			},
+		}
+		volumes = append(volumes, caVolume)
+	}
+
+	volumes = append(volumes, types.TmpVolume())
+
+	return volumes
+}
+
+func (d *deploymentMutator) volumeMounts() []corev1.VolumeMount {
+	volumeMounts := []corev1.VolumeMount{
+		{
+			Name:      "config",
+			MountPath: "/app/ls/config",
+		},
+		{
+			Name:      d.mcpKubeconfigSecretName(),
+			MountPath: kubeconfigs.MountPath(d.mcpKubeconfigSecretName()),
+		},
+	}
+
+	if d.values.CAConfigMap != nil {
+		volumeMounts = append(volumeMounts, corev1.VolumeMount{
+			Name:      configmapsync.CustomCaVolumeName,
+			MountPath: configmapsync.CustomCaPath,
+			ReadOnly:  true,
+		})
+	}
+
+	volumeMounts = append(volumeMounts, types.TmpVolumeMount())
+
+	return volumeMounts
+}
+
+func (d *deploymentMutator) args() []string {
+	a := []string{
+		"--config=/app/ls/config/config.yaml",
+	}
+	if d.values.VerbosityLevel != "" {
+		a = append(a, fmt.Sprintf("-v=%s", d.values.VerbosityLevel))
+	}
+	return a
+}
+
+func (d *deploymentMutator) env() []corev1.EnvVar {
+	envVars := []corev1.EnvVar{
+		{
+			Name:  "KUBECONFIG",
+			Value: kubeconfigs.KubeconfigPath(d.mcpKubeconfigSecretName()),
+		},
+		{
+			Name: "MY_POD_NAME",
+			ValueFrom: &corev1.EnvVarSource{
+				FieldRef: &corev1.ObjectFieldSelector{
+					FieldPath: "metadata.name",
+				},
+			},
+		},
+		{
+			Name: "MY_POD_NAMESPACE",
+			ValueFrom: &corev1.EnvVarSource{
+				FieldRef: &corev1.ObjectFieldSelector{
+					FieldPath: "metadata.namespace",
+				},
+			},
+		},
+	}
+
+	if d.values.CAConfigMap != nil {
+		caEnvVar := corev1.EnvVar{
+			Name:  "SSL_CERT_DIR",
+			Value: configmapsync.SSLCertDirEnvValue(),
+		}
+		envVars = append(envVars, caEnvVar)
+	}
+
+	return envVars
+}
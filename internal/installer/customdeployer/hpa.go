@@ -0,0 +1,44 @@
+package customdeployer
+
+import (
+	"fmt"
+
+	v2 "k8s.io/api/autoscaling/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/openmcp-project/controller-utils/pkg/resources"
+)
+
+type hpaMutator struct {
+	*valuesHelper
+	metadata resources.MetadataMutator
+}
+
+var _ resources.Mutator[*v2.HorizontalPodAutoscaler] = &hpaMutator{}
+
+func newHPAMutator(b *valuesHelper) resources.Mutator[*v2.HorizontalPodAutoscaler] {
+	return &hpaMutator{valuesHelper: b, metadata: resources.NewMetadataMutator()}
+}
+
+func (d *hpaMutator) String() string {
+	return fmt.Sprintf("hpa %s/%s", d.workloadNamespace(), d.customDeployerComponent.NamespacedDefaultResourceName())
+}
+
+func (d *hpaMutator) Empty() *v2.HorizontalPodAutoscaler {
+	return &v2.HorizontalPodAutoscaler{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      d.customDeployerComponent.NamespacedDefaultResourceName(),
+			Namespace: d.workloadNamespace(),
+		},
+	}
+}
+
+func (d *hpaMutator) MetadataMutator() resources.MetadataMutator {
+	return d.metadata
+}
+
+func (d *hpaMutator) Mutate(r *v2.HorizontalPodAutoscaler) error {
+	r.Labels = d.customDeployerComponent.Labels()
+	r.Spec = d.values.HPA.Spec(d.customDeployerComponent.NamespacedDefaultResourceName())
+	return nil
+}
@@ -0,0 +1,129 @@
+package customdeployer
+
+import (
+	"context"
+
+	"github.com/openmcp-project/controller-utils/pkg/readiness"
+	"github.com/openmcp-project/controller-utils/pkg/resources"
+
+	imgpullsecrets "github.com/openmcp-project/service-provider-landscaper/internal/shared/imagepullsecrets"
+	"github.com/openmcp-project/service-provider-landscaper/internal/shared/verticalscaling"
+)
+
+type Exports struct {
+	DeploymentName string
+}
+
+func InstallCustomDeployer(ctx context.Context, values *Values) (*Exports, error) {
+
+	valHelper, err := newValuesHelper(values)
+	if err != nil {
+		return nil, err
+	}
+
+	workloadClient := values.WorkloadCluster.Client()
+
+	if err := resources.CreateOrUpdateResource(ctx, workloadClient, values.Instance.NamespaceMutator()); err != nil {
+		return nil, err
+	}
+
+	imgPullSecretsSync := imgpullsecrets.SecretSync{
+		Sources:                  values.Sources,
+		WorkloadCluster:          values.WorkloadCluster,
+		WorkloadClusterNamespace: valHelper.workloadNamespace(),
+	}
+
+	imagePullSecrets, err := imgPullSecretsSync.CreateOrUpdate(ctx, valHelper.customDeployerComponent, values.Image.ImagePullSecrets)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := resources.CreateOrUpdateResource(ctx, workloadClient, newConfigSecretMutator(valHelper)); err != nil {
+		return nil, err
+	}
+
+	if err := resources.CreateOrUpdateResource(ctx, workloadClient, newKubeconfigSecretMutator(valHelper)); err != nil {
+		return nil, err
+	}
+
+	if err := resources.CreateOrUpdateResource(ctx, workloadClient, newHPAMutator(valHelper)); err != nil {
+		return nil, err
+	}
+
+	if err := vpa(valHelper).Apply(ctx, valHelper.customDeployerComponent, verticalscaling.ForAutoscaler(values.VerticalScaling, &values.HPA)); err != nil {
+		return nil, err
+	}
+
+	if err := resources.CreateOrUpdateResource(ctx, workloadClient, newDeploymentMutator(valHelper).WithImagePullSecrets(imagePullSecrets).Convert()); err != nil {
+		return nil, err
+	}
+
+	return &Exports{
+		// needed for health checks
+		DeploymentName: valHelper.customDeployerComponent.NamespacedDefaultResourceName(),
+	}, nil
+}
+
+func UninstallCustomDeployer(ctx context.Context, values *Values) error {
+
+	valHelper, err := newValuesHelperForDelete(values)
+	if err != nil {
+		return err
+	}
+
+	workloadClient := values.WorkloadCluster.Client()
+
+	if err := resources.DeleteResource(ctx, workloadClient, newDeploymentMutator(valHelper).Convert()); err != nil {
+		return err
+	}
+
+	if err := vpa(valHelper).Delete(ctx, valHelper.customDeployerComponent); err != nil {
+		return err
+	}
+
+	if err := resources.DeleteResource(ctx, workloadClient, newHPAMutator(valHelper)); err != nil {
+		return err
+	}
+
+	if err := resources.DeleteResource(ctx, workloadClient, newKubeconfigSecretMutator(valHelper)); err != nil {
+		return err
+	}
+
+	if err := resources.DeleteResource(ctx, workloadClient, newConfigSecretMutator(valHelper)); err != nil {
+		return err
+	}
+
+	imgPullSecretsSync := imgpullsecrets.SecretSync{
+		Sources:                  values.Sources,
+		WorkloadCluster:          values.WorkloadCluster,
+		WorkloadClusterNamespace: valHelper.workloadNamespace(),
+	}
+
+	if err := imgPullSecretsSync.Delete(ctx, valHelper.customDeployerComponent, values.Image.ImagePullSecrets); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func CheckReadiness(ctx context.Context, values *Values) readiness.CheckResult {
+	valHelper, err := newValuesHelperForDelete(values)
+	if err != nil {
+		return readiness.NewFailedResult(err)
+	}
+
+	hostClient := values.WorkloadCluster.Client()
+	dp, err := resources.GetResource(ctx, hostClient, newDeploymentMutator(valHelper).Convert())
+	if err != nil {
+		return readiness.NewFailedResult(err)
+	}
+	return readiness.CheckDeployment(dp)
+}
+
+// vpa returns the helper for the vertical pod autoscaler of the deployer.
+func vpa(valHelper *valuesHelper) *verticalscaling.VPA {
+	return &verticalscaling.VPA{
+		WorkloadCluster:          valHelper.values.WorkloadCluster,
+		WorkloadClusterNamespace: valHelper.workloadNamespace(),
+	}
+}
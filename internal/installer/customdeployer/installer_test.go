@@ -0,0 +1,109 @@
+package customdeployer_test
+
+import (
+	"testing"
+
+	"github.com/openmcp-project/service-provider-landscaper/internal/installer/customdeployer"
+	"github.com/openmcp-project/service-provider-landscaper/internal/installer/rbac"
+
+	"github.com/openmcp-project/controller-utils/pkg/clusters"
+	testutils "github.com/openmcp-project/controller-utils/pkg/testing"
+	clustersv1alpha1 "github.com/openmcp-project/openmcp-operator/api/clusters/v1alpha1"
+	deploymentv1alpha1 "github.com/openmcp-project/openmcp-operator/api/provider/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lsv1alpha2 "github.com/openmcp-project/service-provider-landscaper/api/v1alpha2"
+)
+
+const (
+	version = "v0.135.0"
+)
+
+func TestConfig(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Custom Deployer Installer Test Suite")
+}
+
+func buildTestEnvironment(testdataDir string, objectsWithStatus ...client.Object) *testutils.Environment {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(clustersv1alpha1.AddToScheme(scheme))
+	utilruntime.Must(deploymentv1alpha1.AddToScheme(scheme))
+	utilruntime.Must(lsv1alpha2.AddToScheme(scheme))
+
+	return testutils.NewEnvironmentBuilder().
+		WithFakeClient(scheme).
+		WithInitObjectPath("testdata", testdataDir).
+		WithInitObjects(objectsWithStatus...).
+		Build()
+}
+
+var _ = Describe("Custom Deployer Installer", func() {
+	const instanceID = "test-g23tp"
+
+	It("should install the custom deployer", func() {
+		env := buildTestEnvironment("test-01")
+
+		workloadCluster := clusters.NewTestClusterFromClient("workload", env.Client())
+		mcpCluster := clusters.NewTestClusterFromClient("mcp", env.Client())
+
+		kubeconfig, err := rbac.TestKubeconfigAccessorImpl(env.Ctx, mcpCluster)
+		Expect(err).ToNot(HaveOccurred())
+
+		providerConfig := lsv1alpha2.ProviderConfig{}
+		Expect(env.Client().Get(env.Ctx, client.ObjectKey{Name: "default"}, &providerConfig)).To(Succeed())
+
+		offering := providerConfig.Spec.Deployment.GetDeployerOffering("terraform")
+		Expect(offering).ToNot(BeNil())
+
+		values := &customdeployer.Values{
+			Instance:             instanceID,
+			Version:              version,
+			Name:                 offering.Name,
+			WorkloadCluster:      workloadCluster,
+			MCPClusterKubeconfig: string(kubeconfig),
+			Image: lsv1alpha2.ImageConfiguration{
+				Image: providerConfig.GetDeployerImageLocation(offering, version),
+			},
+			Configuration: offering.Configuration,
+		}
+
+		Expect(values.Image.Image).To(Equal("other.registry.test/deployers/terraform-deployer:v1.2.0"))
+
+		exports, err := customdeployer.InstallCustomDeployer(env.Ctx, values)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(exports.DeploymentName).To(Equal("terraform-deployer"))
+
+		configSecret := &corev1.Secret{}
+		Expect(env.Client().Get(env.Ctx, client.ObjectKey{Name: "terraform-deployer-config", Namespace: "ls-system-" + instanceID}, configSecret)).To(Succeed())
+		Expect(string(configSecret.Data["config.yaml"])).To(ContainSubstring("identity: terraform"))
+	})
+
+	It("should uninstall the custom deployer", func() {
+		env := buildTestEnvironment("test-01")
+
+		workloadCluster := clusters.NewTestClusterFromClient("workload", env.Client())
+		mcpCluster := clusters.NewTestClusterFromClient("mcp", env.Client())
+
+		kubeconfig, err := rbac.TestKubeconfigAccessorImpl(env.Ctx, mcpCluster)
+		Expect(err).ToNot(HaveOccurred())
+
+		values := &customdeployer.Values{
+			Instance:             instanceID,
+			Name:                 "terraform",
+			WorkloadCluster:      workloadCluster,
+			MCPClusterKubeconfig: string(kubeconfig),
+		}
+
+		Expect(customdeployer.UninstallCustomDeployer(env.Ctx, values)).ToNot(HaveOccurred())
+	})
+
+})
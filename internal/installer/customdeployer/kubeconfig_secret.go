@@ -0,0 +1,16 @@
+package customdeployer
+
+import (
+	"github.com/openmcp-project/controller-utils/pkg/resources"
+	v1 "k8s.io/api/core/v1"
+)
+
+func newKubeconfigSecretMutator(b *valuesHelper) resources.Mutator[*v1.Secret] {
+	m := resources.NewSecretMutator(
+		b.mcpKubeconfigSecretName(),
+		b.workloadNamespace(),
+		b.mcpKubeconfig.Data(),
+		v1.SecretTypeOpaque)
+	m.MetadataMutator().WithLabels(b.customDeployerComponent.Labels())
+	return m
+}
@@ -0,0 +1,69 @@
+package customdeployer
+
+import (
+	"github.com/openmcp-project/controller-utils/pkg/clusters"
+	core "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+
+	api "github.com/openmcp-project/service-provider-landscaper/api/v1alpha2"
+	"github.com/openmcp-project/service-provider-landscaper/internal/shared/identity"
+	"github.com/openmcp-project/service-provider-landscaper/internal/shared/sources"
+	"github.com/openmcp-project/service-provider-landscaper/internal/shared/types"
+)
+
+type Values struct {
+	Instance identity.Instance `json:"instance,omitempty"`
+	Version  string            `json:"version,omitempty"`
+	// Name is the name of the deployer as offered in the ProviderConfig.
+	Name                 string `json:"name,omitempty"`
+	Sources              *sources.Resolver
+	WorkloadCluster      *clusters.Cluster
+	VerbosityLevel       string                       `json:"verbosityLevel,omitempty"`
+	MCPClusterKubeconfig string                       `json:"mcpClusterKubeconfig,omitempty"`
+	Image                api.ImageConfiguration       `json:"image,omitempty"`
+	ReplicaCount         *int32                       `json:"replicaCount,omitempty"`
+	Resources            core.ResourceRequirements    `json:"resources,omitempty"`
+	PodSecurityContext   *core.PodSecurityContext     `json:"podSecurityContext,omitempty"`
+	SecurityContext      *core.SecurityContext        `json:"securityContext,omitempty"`
+	Scheduling           *types.SchedulingValues      `json:"scheduling,omitempty"`
+	VerticalScaling      *types.VerticalScalingValues `json:"verticalScaling,omitempty"`
+	// Configuration is written unchanged into the configuration file of the deployer.
+	Configuration *runtime.RawExtension      `json:"configuration,omitempty"`
+	HPA           types.HPAValues            `json:"hpa,omitempty"`
+	CAConfigMap   *core.ConfigMapKeySelector `json:"caConfigMap,omitempty"`
+}
+
+func (v *Values) Default() error {
+	if v.VerbosityLevel == "" {
+		v.VerbosityLevel = "info"
+	}
+	if v.ReplicaCount == nil {
+		v.ReplicaCount = ptr.To(int32(1))
+	}
+	if v.Resources.Requests == nil {
+		cpu, err := resource.ParseQuantity("100m")
+		if err != nil {
+			return err
+		}
+		memory, err := resource.ParseQuantity("100Mi")
+		if err != nil {
+			return err
+		}
+		v.Resources.Requests = core.ResourceList{
+			core.ResourceCPU:    cpu,
+			core.ResourceMemory: memory,
+		}
+	}
+	v.HPA.Default(1, 1)
+
+	if v.PodSecurityContext == nil {
+		v.PodSecurityContext = types.DefaultPodSecurityContext()
+	}
+	if v.SecurityContext == nil {
+		v.SecurityContext = types.DefaultSecurityContext()
+	}
+
+	return nil
+}
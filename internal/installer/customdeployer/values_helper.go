@@ -0,0 +1,93 @@
+package customdeployer
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/openmcp-project/service-provider-landscaper/internal/shared/identity"
+	"github.com/openmcp-project/service-provider-landscaper/internal/shared/kubeconfigs"
+)
+
+type valuesHelper struct {
+	values *Values
+
+	customDeployerComponent *identity.Component
+
+	configYaml []byte
+	configHash string
+
+	mcpKubeconfig *kubeconfigs.Secret
+}
+
+func newValuesHelper(values *Values) (*valuesHelper, error) {
+	if values == nil {
+		return nil, fmt.Errorf("values must not be nil")
+	}
+	if values.Name == "" {
+		return nil, fmt.Errorf("custom deployer name must not be empty")
+	}
+	if err := values.Default(); err != nil {
+		return nil, fmt.Errorf("failed to apply default values of custom deployer %s: %w", values.Name, err)
+	}
+
+	// compute values
+	var err error
+	configYaml := []byte("{}\n")
+	if values.Configuration != nil && len(values.Configuration.Raw) > 0 {
+		configYaml, err = yaml.JSONToYAML(values.Configuration.Raw)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert config of custom deployer %s: %w", values.Name, err)
+		}
+	}
+	hash := sha256.Sum256(configYaml)
+	configHash := hex.EncodeToString(hash[:])
+
+	h := &valuesHelper{
+		values:                  values,
+		customDeployerComponent: identity.NewComponent(values.Instance, values.Version, componentName(values.Name)),
+		configYaml:              configYaml,
+		configHash:              configHash,
+	}
+
+	if h.mcpKubeconfig, err = kubeconfigs.NewSecret(h.mcpClusterKubeconfig(), h.mcpKubeconfigSecretName()); err != nil {
+		return nil, err
+	}
+	return h, nil
+}
+
+func newValuesHelperForDelete(values *Values) (*valuesHelper, error) {
+	if values == nil {
+		return nil, fmt.Errorf("values must not be nil")
+	}
+	if values.Name == "" {
+		return nil, fmt.Errorf("custom deployer name must not be empty")
+	}
+	if err := values.Default(); err != nil {
+		return nil, fmt.Errorf("failed to apply default values of custom deployer %s during delete operation: %w", values.Name, err)
+	}
+
+	return &valuesHelper{
+		values:                  values,
+		customDeployerComponent: identity.NewComponent(values.Instance, values.Version, componentName(values.Name)),
+	}, nil
+}
+
+// componentName returns the name of the component of a custom deployer, which is also the name of its deployment.
+func componentName(name string) string {
+	return fmt.Sprintf("%s-deployer", name)
+}
+
+func (h *valuesHelper) workloadNamespace() string {
+	return h.values.Instance.Namespace()
+}
+
+func (h *valuesHelper) mcpKubeconfigSecretName() string {
+	return h.customDeployerComponent.NamespacedResourceName("mcp-kubeconfig")
+}
+
+func (h *valuesHelper) mcpClusterKubeconfig() []byte {
+	return []byte(h.values.MCPClusterKubeconfig)
+}
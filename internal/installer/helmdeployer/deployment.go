@@ -1,8 +1,6 @@
 package helmdeployer
 
 import (
-	"crypto/sha256"
-	"encoding/hex"
 	"fmt"
 	"strconv"
 
@@ -15,6 +13,8 @@ import (
 	"github.com/openmcp-project/controller-utils/pkg/resources"
 
 	configmapsync "github.com/openmcp-project/service-provider-landscaper/internal/shared/configmaps"
+	"github.com/openmcp-project/service-provider-landscaper/internal/shared/kubeconfigs"
+	"github.com/openmcp-project/service-provider-landscaper/internal/shared/types"
 )
 
 type deploymentMutator struct {
@@ -77,6 +77,7 @@ func (d *deploymentMutator) Mutate(r *appsv1.Deployment) error {
 			},
 		},
 	}
+	d.values.Scheduling.ApplyTo(&r.Spec.Template.Spec, d.helmDeployerComponent.TopologyLabels())
 	return nil
 }
 
@@ -89,13 +90,10 @@ func (d *deploymentMutator) strategy() appsv1.DeploymentStrategy {
 }
 
 func (d *deploymentMutator) templateAnnotations() map[string]string {
-	hash := sha256.Sum256([]byte(d.values.MCPClusterKubeconfig))
-	mcpKubeconfigHash := hex.EncodeToString(hash[:])
-
 	annotations := map[string]string{
 		"checksum/config":          d.configHash,
 		"checksum/registrysecrets": d.registrySecretsHash,
-		"checksum/mcpKubeconfig":   mcpKubeconfigHash,
+		"checksum/mcpKubeconfig":   d.mcpKubeconfig.Hash(),
 	}
 	return annotations
 }
@@ -164,6 +162,8 @@ func (d *deploymentMutator) volumes() []corev1.Volume {
 		volumes = append(volumes, caVolume)
 	}
 
+	volumes = append(volumes, types.TmpVolume())
+
 	return volumes
 }
 
@@ -175,14 +175,14 @@ func (d *deploymentMutator) volumeMounts() []corev1.VolumeMount {
 		},
 		{
 			Name:      d.mcpKubeconfigSecretName(),
-			MountPath: fmt.Sprint("/app/ls/", d.mcpKubeconfigSecretName()),
+			MountPath: kubeconfigs.MountPath(d.mcpKubeconfigSecretName()),
 		},
 	}
 
 	if d.values.OCI != nil {
 		volumeMounts = append(volumeMounts, corev1.VolumeMount{
 			Name:      "ociregistry",
-			MountPath: "/app/ls/registry/secrets",
+			MountPath: registrySecretsPath,
 		})
 	}
 
@@ -194,6 +194,8 @@ func (d *deploymentMutator) volumeMounts() []corev1.VolumeMount {
 		})
 	}
 
+	volumeMounts = append(volumeMounts, types.TmpVolumeMount())
+
 	return volumeMounts
 }
 
@@ -211,7 +213,7 @@ func (d *deploymentMutator) env() []corev1.EnvVar {
 	envVars := []corev1.EnvVar{
 		{
 			Name:  "KUBECONFIG",
-			Value: fmt.Sprintf("/app/ls/%s/kubeconfig", d.mcpKubeconfigSecretName()),
+			Value: kubeconfigs.KubeconfigPath(d.mcpKubeconfigSecretName()),
 		},
 		{
 			Name: "MY_POD_NAME",
@@ -5,7 +5,6 @@ import (
 
 	v2 "k8s.io/api/autoscaling/v2"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/utils/ptr"
 
 	"github.com/openmcp-project/controller-utils/pkg/resources"
 )
@@ -40,36 +39,6 @@ func (d *hpaMutator) MetadataMutator() resources.MetadataMutator {
 
 func (d *hpaMutator) Mutate(r *v2.HorizontalPodAutoscaler) error {
 	r.Labels = d.helmDeployerComponent.Labels()
-	r.Spec = v2.HorizontalPodAutoscalerSpec{
-		ScaleTargetRef: v2.CrossVersionObjectReference{
-			APIVersion: "apps/v1",
-			Kind:       "Deployment",
-			Name:       d.helmDeployerComponent.NamespacedDefaultResourceName(),
-		},
-		MinReplicas: ptr.To[int32](1),
-		MaxReplicas: d.values.HPA.MaxReplicas,
-		Metrics: []v2.MetricSpec{
-			{
-				Type: "Resource",
-				Resource: &v2.ResourceMetricSource{
-					Name: "cpu",
-					Target: v2.MetricTarget{
-						Type:               "Utilization",
-						AverageUtilization: d.values.HPA.AverageCpuUtilization,
-					},
-				},
-			},
-			{
-				Type: "Resource",
-				Resource: &v2.ResourceMetricSource{
-					Name: "memory",
-					Target: v2.MetricTarget{
-						Type:               "Utilization",
-						AverageUtilization: d.values.HPA.AverageMemoryUtilization,
-					},
-				},
-			},
-		},
-	}
+	r.Spec = d.values.HPA.Spec(d.helmDeployerComponent.NamespacedDefaultResourceName())
 	return nil
 }
@@ -7,6 +7,7 @@ import (
 	"github.com/openmcp-project/controller-utils/pkg/resources"
 
 	imgpullsecrets "github.com/openmcp-project/service-provider-landscaper/internal/shared/imagepullsecrets"
+	"github.com/openmcp-project/service-provider-landscaper/internal/shared/verticalscaling"
 )
 
 type Exports struct {
@@ -22,13 +23,12 @@ func InstallHelmDeployer(ctx context.Context, values *Values) (*Exports, error)
 
 	workloadClient := values.WorkloadCluster.Client()
 
-	if err := resources.CreateOrUpdateResource(ctx, workloadClient, resources.NewNamespaceMutator(valHelper.workloadNamespace())); err != nil {
+	if err := resources.CreateOrUpdateResource(ctx, workloadClient, values.Instance.NamespaceMutator()); err != nil {
 		return nil, err
 	}
 
 	imgPullSecretsSync := imgpullsecrets.SecretSync{
-		PlatformCluster:          values.PlatformCluster,
-		PlatformClusterNamespace: values.PlatformClusterNamespace,
+		Sources:                  values.Sources,
 		WorkloadCluster:          values.WorkloadCluster,
 		WorkloadClusterNamespace: valHelper.workloadNamespace(),
 	}
@@ -56,10 +56,29 @@ func InstallHelmDeployer(ctx context.Context, values *Values) (*Exports, error)
 		return nil, err
 	}
 
+	if err := vpa(valHelper).Apply(ctx, valHelper.helmDeployerComponent, verticalscaling.ForAutoscaler(values.VerticalScaling, &values.HPA)); err != nil {
+		return nil, err
+	}
+
 	if err := resources.CreateOrUpdateResource(ctx, workloadClient, newDeploymentMutator(valHelper).WithImagePullSecrets(imagePullSecrets).Convert()); err != nil {
 		return nil, err
 	}
 
+	if valHelper.values.HPA.MaxReplicas > 1 {
+		if err := resources.CreateOrUpdateResource(ctx, workloadClient, newPDBMutator(valHelper)); err != nil {
+			return nil, err
+		}
+	} else if err := resources.DeleteResource(ctx, workloadClient, newPDBMutator(valHelper)); err != nil {
+		return nil, err
+	}
+
+	// the registries secret is deleted after the deployment no longer mounts it
+	if valHelper.values.OCI == nil {
+		if err := resources.DeleteResource(ctx, workloadClient, newRegistrySecretMutator(valHelper)); err != nil {
+			return nil, err
+		}
+	}
+
 	return &Exports{
 		// needed for health checks
 		DeploymentName: valHelper.helmDeployerComponent.NamespacedDefaultResourceName(),
@@ -79,6 +98,14 @@ func UninstallHelmDeployer(ctx context.Context, values *Values) error {
 		return err
 	}
 
+	if err := resources.DeleteResource(ctx, workloadClient, newPDBMutator(valHelper)); err != nil {
+		return err
+	}
+
+	if err := vpa(valHelper).Delete(ctx, valHelper.helmDeployerComponent); err != nil {
+		return err
+	}
+
 	if err := resources.DeleteResource(ctx, workloadClient, newHPAMutator(valHelper)); err != nil {
 		return err
 	}
@@ -91,9 +118,12 @@ func UninstallHelmDeployer(ctx context.Context, values *Values) error {
 		return err
 	}
 
+	if err := resources.DeleteResource(ctx, workloadClient, newRegistrySecretMutator(valHelper)); err != nil {
+		return err
+	}
+
 	imgPullSecretsSync := imgpullsecrets.SecretSync{
-		PlatformCluster:          values.PlatformCluster,
-		PlatformClusterNamespace: values.PlatformClusterNamespace,
+		Sources:                  values.Sources,
 		WorkloadCluster:          values.WorkloadCluster,
 		WorkloadClusterNamespace: valHelper.workloadNamespace(),
 	}
@@ -118,3 +148,11 @@ func CheckReadiness(ctx context.Context, values *Values) readiness.CheckResult {
 	}
 	return readiness.CheckDeployment(dp)
 }
+
+// vpa returns the helper for the vertical pod autoscaler of the deployer.
+func vpa(valHelper *valuesHelper) *verticalscaling.VPA {
+	return &verticalscaling.VPA{
+		WorkloadCluster:          valHelper.values.WorkloadCluster,
+		WorkloadClusterNamespace: valHelper.workloadNamespace(),
+	}
+}
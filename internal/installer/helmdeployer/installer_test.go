@@ -1,18 +1,29 @@
 package helmdeployer_test
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/openmcp-project/service-provider-landscaper/internal/installer/helmdeployer"
 	"github.com/openmcp-project/service-provider-landscaper/internal/installer/rbac"
+	"github.com/openmcp-project/service-provider-landscaper/internal/shared/identity"
+	"github.com/openmcp-project/service-provider-landscaper/internal/shared/types"
 
 	"github.com/openmcp-project/controller-utils/pkg/clusters"
 	testutils "github.com/openmcp-project/controller-utils/pkg/testing"
 	clustersv1alpha1 "github.com/openmcp-project/openmcp-operator/api/clusters/v1alpha1"
 	deploymentv1alpha1 "github.com/openmcp-project/openmcp-operator/api/provider/v1alpha1"
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -80,6 +91,172 @@ var _ = Describe("Helm Deployer Installer", func() {
 		Expect(err).ToNot(HaveOccurred())
 	})
 
+	It("should install the helm deployer with OCI registry credentials", func() {
+		env := buildTestEnvironment("test-01")
+
+		workloadCluster := clusters.NewTestClusterFromClient("workload", env.Client())
+		mcpCluster := clusters.NewTestClusterFromClient("mcp", env.Client())
+
+		kubeconfig, err := rbac.TestKubeconfigAccessorImpl(env.Ctx, mcpCluster)
+		Expect(err).ToNot(HaveOccurred())
+
+		values := &helmdeployer.Values{
+			Instance:             instanceID,
+			Version:              version,
+			WorkloadCluster:      workloadCluster,
+			MCPClusterKubeconfig: string(kubeconfig),
+			Image: lsv1alpha2.ImageConfiguration{
+				Image: "registry.test/helm-deployer:" + version,
+			},
+			OCI: &helmdeployer.OCIValues{
+				InsecureSkipVerify: true,
+				Secrets: map[string]any{
+					"onboarding-registry.json": json.RawMessage(`{"auths":{"registry.test":{"auth":"dXNlcjpvbGQ="}}}`),
+				},
+			},
+		}
+
+		_, err = helmdeployer.InstallHelmDeployer(env.Ctx, values)
+		Expect(err).ToNot(HaveOccurred())
+
+		namespace := identity.Instance(instanceID).Namespace()
+		registriesSecret := &corev1.Secret{}
+		Expect(env.Client().Get(env.Ctx, client.ObjectKey{Name: "helm-deployer-registries", Namespace: namespace}, registriesSecret)).To(Succeed())
+		Expect(registriesSecret.Data).To(HaveKeyWithValue("onboarding-registry.json", []byte(`{"auths":{"registry.test":{"auth":"dXNlcjpvbGQ="}}}`)))
+
+		configSecret := &corev1.Secret{}
+		Expect(env.Client().Get(env.Ctx, client.ObjectKey{Name: "helm-deployer-config", Namespace: namespace}, configSecret)).To(Succeed())
+		Expect(string(configSecret.Data["config.yaml"])).To(ContainSubstring("/app/ls/registry/secrets/onboarding-registry.json"))
+		Expect(string(configSecret.Data["config.yaml"])).To(ContainSubstring("insecureSkipVerify: true"))
+
+		deployment := &appsv1.Deployment{}
+		Expect(env.Client().Get(env.Ctx, client.ObjectKey{Name: "helm-deployer", Namespace: namespace}, deployment)).To(Succeed())
+		checksum := deployment.Spec.Template.Annotations["checksum/registrysecrets"]
+
+		// rotated credentials roll out the deployment
+		values.OCI.Secrets["onboarding-registry.json"] = json.RawMessage(`{"auths":{"registry.test":{"auth":"dXNlcjpuZXc="}}}`)
+		_, err = helmdeployer.InstallHelmDeployer(env.Ctx, values)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(env.Client().Get(env.Ctx, client.ObjectKeyFromObject(deployment), deployment)).To(Succeed())
+		Expect(deployment.Spec.Template.Annotations["checksum/registrysecrets"]).ToNot(Equal(checksum))
+
+		// without registries, the registries secret is removed
+		values.OCI = nil
+		_, err = helmdeployer.InstallHelmDeployer(env.Ctx, values)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(env.Client().Get(env.Ctx, client.ObjectKeyFromObject(registriesSecret), registriesSecret)).ToNot(Succeed())
+	})
+
+	It("should protect a scalable helm deployer with a pod disruption budget", func() {
+		env := buildTestEnvironment("test-01")
+
+		workloadCluster := clusters.NewTestClusterFromClient("workload", env.Client())
+		mcpCluster := clusters.NewTestClusterFromClient("mcp", env.Client())
+
+		kubeconfig, err := rbac.TestKubeconfigAccessorImpl(env.Ctx, mcpCluster)
+		Expect(err).ToNot(HaveOccurred())
+
+		values := &helmdeployer.Values{
+			Instance:             instanceID,
+			Version:              version,
+			WorkloadCluster:      workloadCluster,
+			MCPClusterKubeconfig: string(kubeconfig),
+			Image: lsv1alpha2.ImageConfiguration{
+				Image: "registry.test/helm-deployer:" + version,
+			},
+			HPA: types.HPAValues{MaxReplicas: 3},
+		}
+
+		_, err = helmdeployer.InstallHelmDeployer(env.Ctx, values)
+		Expect(err).ToNot(HaveOccurred())
+
+		pdb := &policyv1.PodDisruptionBudget{}
+		pdbKey := client.ObjectKey{Name: "helm-deployer", Namespace: identity.Instance(instanceID).Namespace()}
+		Expect(env.Client().Get(env.Ctx, pdbKey, pdb)).To(Succeed())
+		Expect(pdb.Spec.MaxUnavailable).To(HaveValue(Equal(intstr.FromInt32(1))))
+
+		deployment := &appsv1.Deployment{}
+		Expect(env.Client().Get(env.Ctx, pdbKey, deployment)).To(Succeed())
+		Expect(pdb.Spec.Selector).To(Equal(deployment.Spec.Selector))
+
+		// a single replica is not protected
+		values.HPA.MaxReplicas = 1
+		_, err = helmdeployer.InstallHelmDeployer(env.Ctx, values)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(env.Client().Get(env.Ctx, pdbKey, pdb)).ToNot(Succeed())
+	})
+
+	It("should scale the helm deployer with custom metrics and a scaling behavior", func() {
+		env := buildTestEnvironment("test-01")
+
+		workloadCluster := clusters.NewTestClusterFromClient("workload", env.Client())
+		mcpCluster := clusters.NewTestClusterFromClient("mcp", env.Client())
+
+		kubeconfig, err := rbac.TestKubeconfigAccessorImpl(env.Ctx, mcpCluster)
+		Expect(err).ToNot(HaveOccurred())
+
+		queuedDeployItems := autoscalingv2.MetricSpec{
+			Type: autoscalingv2.ExternalMetricSourceType,
+			External: &autoscalingv2.ExternalMetricSource{
+				Metric: autoscalingv2.MetricIdentifier{
+					Name:     "landscaper_queued_deployitems",
+					Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"deployer": "helm"}},
+				},
+				Target: autoscalingv2.MetricTarget{
+					Type:         autoscalingv2.AverageValueMetricType,
+					AverageValue: ptr.To(resource.MustParse("10")),
+				},
+			},
+		}
+		behavior := &autoscalingv2.HorizontalPodAutoscalerBehavior{
+			ScaleDown: &autoscalingv2.HPAScalingRules{StabilizationWindowSeconds: ptr.To[int32](600)},
+		}
+
+		values := &helmdeployer.Values{
+			Instance:             instanceID,
+			Version:              version,
+			WorkloadCluster:      workloadCluster,
+			MCPClusterKubeconfig: string(kubeconfig),
+			Image: lsv1alpha2.ImageConfiguration{
+				Image: "registry.test/helm-deployer:" + version,
+			},
+			HPA: types.HPAValues{
+				MinReplicas:           ptr.To[int32](2),
+				AverageCpuUtilization: ptr.To[int32](70),
+				Behavior:              behavior,
+				Metrics:               []autoscalingv2.MetricSpec{queuedDeployItems},
+			},
+		}
+
+		_, err = helmdeployer.InstallHelmDeployer(env.Ctx, values)
+		Expect(err).ToNot(HaveOccurred())
+
+		hpa := &autoscalingv2.HorizontalPodAutoscaler{}
+		hpaKey := client.ObjectKey{Name: "helm-deployer", Namespace: identity.Instance(instanceID).Namespace()}
+		Expect(env.Client().Get(env.Ctx, hpaKey, hpa)).To(Succeed())
+		Expect(hpa.Spec.MinReplicas).To(HaveValue(Equal(int32(2))))
+		// the maximum replicas are raised to the minimum replicas
+		Expect(hpa.Spec.MaxReplicas).To(Equal(int32(2)))
+		Expect(hpa.Spec.Behavior).To(Equal(behavior))
+		// with additional metrics, only the utilization targets which are set are added
+		Expect(hpa.Spec.Metrics).To(HaveLen(2))
+		Expect(hpa.Spec.Metrics[0].Resource.Name).To(Equal(corev1.ResourceCPU))
+		Expect(hpa.Spec.Metrics[0].Resource.Target.AverageUtilization).To(HaveValue(Equal(int32(70))))
+		Expect(hpa.Spec.Metrics[1]).To(Equal(queuedDeployItems))
+
+		// a scalable deployer is protected by a pod disruption budget
+		Expect(env.Client().Get(env.Ctx, hpaKey, &policyv1.PodDisruptionBudget{})).To(Succeed())
+
+		// the deployer can be scaled on the additional metrics alone
+		values.HPA.AverageCpuUtilization = nil
+		_, err = helmdeployer.InstallHelmDeployer(env.Ctx, values)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(env.Client().Get(env.Ctx, hpaKey, hpa)).To(Succeed())
+		Expect(hpa.Spec.Metrics).To(Equal([]autoscalingv2.MetricSpec{queuedDeployItems}))
+	})
+
 	It("should uninstall the helm deployer", func() {
 		env := buildTestEnvironment("test-01")
 
@@ -9,9 +9,7 @@ func newKubeconfigSecretMutator(b *valuesHelper) resources.Mutator[*v1.Secret] {
 	m := resources.NewSecretMutator(
 		b.mcpKubeconfigSecretName(),
 		b.workloadNamespace(),
-		map[string][]byte{
-			"kubeconfig": b.mcpClusterKubeconfig(),
-		},
+		b.mcpKubeconfig.Data(),
 		v1.SecretTypeOpaque)
 	m.MetadataMutator().WithLabels(b.helmDeployerComponent.Labels())
 	return m
@@ -0,0 +1,50 @@
+package helmdeployer
+
+import (
+	"fmt"
+
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+
+	"github.com/openmcp-project/controller-utils/pkg/resources"
+)
+
+type pdbMutator struct {
+	*valuesHelper
+	metadata resources.MetadataMutator
+}
+
+var _ resources.Mutator[*policyv1.PodDisruptionBudget] = &pdbMutator{}
+
+func newPDBMutator(b *valuesHelper) resources.Mutator[*policyv1.PodDisruptionBudget] {
+	return &pdbMutator{valuesHelper: b, metadata: resources.NewMetadataMutator()}
+}
+
+func (d *pdbMutator) String() string {
+	return fmt.Sprintf("pdb %s/%s", d.workloadNamespace(), d.helmDeployerComponent.NamespacedDefaultResourceName())
+}
+
+func (d *pdbMutator) Empty() *policyv1.PodDisruptionBudget {
+	return &policyv1.PodDisruptionBudget{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      d.helmDeployerComponent.NamespacedDefaultResourceName(),
+			Namespace: d.workloadNamespace(),
+		},
+	}
+}
+
+func (d *pdbMutator) MetadataMutator() resources.MetadataMutator {
+	return d.metadata
+}
+
+func (d *pdbMutator) Mutate(r *policyv1.PodDisruptionBudget) error {
+	r.Labels = d.helmDeployerComponent.Labels()
+	r.Spec = policyv1.PodDisruptionBudgetSpec{
+		MaxUnavailable:             ptr.To(intstr.FromInt32(1)),
+		Selector:                   &metav1.LabelSelector{MatchLabels: d.helmDeployerComponent.SelectorLabels()},
+		UnhealthyPodEvictionPolicy: ptr.To(policyv1.AlwaysAllow),
+	}
+	return nil
+}
@@ -2,8 +2,11 @@ package helmdeployer
 
 import (
 	"fmt"
+	"path"
+	"slices"
 
 	"github.com/openmcp-project/controller-utils/pkg/clusters"
+	config "github.com/openmcp-project/landscaper/apis/config/v1alpha1"
 	"github.com/openmcp-project/landscaper/apis/deployer/helm/v1alpha1"
 	core "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
@@ -11,29 +14,31 @@ import (
 
 	api "github.com/openmcp-project/service-provider-landscaper/api/v1alpha2"
 	"github.com/openmcp-project/service-provider-landscaper/internal/shared/identity"
+	"github.com/openmcp-project/service-provider-landscaper/internal/shared/sources"
 	"github.com/openmcp-project/service-provider-landscaper/internal/shared/types"
 )
 
 type Values struct {
-	Instance                 identity.Instance `json:"instance,omitempty"`
-	Version                  string            `json:"version,omitempty"`
-	PlatformCluster          *clusters.Cluster
-	PlatformClusterNamespace string `json:"platformClusterNamespace,omitempty"`
-	MCPCluster               *clusters.Cluster
-	WorkloadCluster          *clusters.Cluster
-	VerbosityLevel           string                     `json:"verbosityLevel,omitempty"`
-	MCPClusterKubeconfig     string                     `json:"mcpClusterKubeconfig,omitempty"`
-	Image                    api.ImageConfiguration     `json:"image,omitempty"`
-	ReplicaCount             *int32                     `json:"replicaCount,omitempty"`
-	Resources                core.ResourceRequirements  `json:"resources,omitempty"` // <<<
-	PodSecurityContext       *core.PodSecurityContext   `json:"podSecurityContext,omitempty"`
-	SecurityContext          *core.SecurityContext      `json:"securityContext,omitempty"`
-	Configuration            v1alpha1.Configuration     `json:"configuration,omitempty"`
-	WorkloadClientSettings   *ClientSettings            `json:"workloadClientSettings,omitempty"`
-	MCPClientSettings        *ClientSettings            `json:"mcpClientSettings,omitempty"`
-	HPA                      types.HPAValues            `json:"hpa,omitempty"`
-	OCI                      *OCIValues                 `json:"oci,omitempty"`
-	CAConfigMap              *core.ConfigMapKeySelector `json:"caConfigMap,omitempty"`
+	Instance               identity.Instance `json:"instance,omitempty"`
+	Version                string            `json:"version,omitempty"`
+	Sources                *sources.Resolver
+	MCPCluster             *clusters.Cluster
+	WorkloadCluster        *clusters.Cluster
+	VerbosityLevel         string                       `json:"verbosityLevel,omitempty"`
+	MCPClusterKubeconfig   string                       `json:"mcpClusterKubeconfig,omitempty"`
+	Image                  api.ImageConfiguration       `json:"image,omitempty"`
+	ReplicaCount           *int32                       `json:"replicaCount,omitempty"`
+	Resources              core.ResourceRequirements    `json:"resources,omitempty"` // <<<
+	PodSecurityContext     *core.PodSecurityContext     `json:"podSecurityContext,omitempty"`
+	SecurityContext        *core.SecurityContext        `json:"securityContext,omitempty"`
+	Scheduling             *types.SchedulingValues      `json:"scheduling,omitempty"`
+	VerticalScaling        *types.VerticalScalingValues `json:"verticalScaling,omitempty"`
+	Configuration          v1alpha1.Configuration       `json:"configuration,omitempty"`
+	WorkloadClientSettings *ClientSettings              `json:"workloadClientSettings,omitempty"`
+	MCPClientSettings      *ClientSettings              `json:"mcpClientSettings,omitempty"`
+	HPA                    types.HPAValues              `json:"hpa,omitempty"`
+	OCI                    *OCIValues                   `json:"oci,omitempty"`
+	CAConfigMap            *core.ConfigMapKeySelector   `json:"caConfigMap,omitempty"`
 }
 
 type ReleaseValues struct {
@@ -55,11 +60,15 @@ type ServiceAccountValues struct {
 }
 
 type OCIValues struct {
-	AllowPlainHttp     bool           `json:"allowPlainHttp,omitempty"`
-	InsecureSkipVerify bool           `json:"insecureSkipVerify,omitempty"`
-	Secrets            map[string]any `json:"secrets,omitempty"`
+	AllowPlainHttp     bool `json:"allowPlainHttp,omitempty"`
+	InsecureSkipVerify bool `json:"insecureSkipVerify,omitempty"`
+	// Secrets maps file names to the content of docker config files with registry credentials.
+	Secrets map[string]any `json:"secrets,omitempty"`
 }
 
+// registrySecretsPath is the directory in which the docker config files of the registries secret are mounted.
+const registrySecretsPath = "/app/ls/registry/secrets"
+
 func (v *Values) Default() error {
 	if v.VerbosityLevel == "" {
 		v.VerbosityLevel = "info"
@@ -80,6 +89,18 @@ func (v *Values) Default() error {
 	if v.Configuration.Controller.Workers == 0 {
 		v.Configuration.Controller.Workers = 30
 	}
+	if v.OCI != nil && v.Configuration.OCI == nil {
+		configFiles := make([]string, 0, len(v.OCI.Secrets))
+		for key := range v.OCI.Secrets {
+			configFiles = append(configFiles, path.Join(registrySecretsPath, key))
+		}
+		slices.Sort(configFiles)
+		v.Configuration.OCI = &config.OCIConfiguration{
+			ConfigFiles:        configFiles,
+			AllowPlainHttp:     v.OCI.AllowPlainHttp,
+			InsecureSkipVerify: v.OCI.InsecureSkipVerify,
+		}
+	}
 	if v.WorkloadClientSettings == nil {
 		v.WorkloadClientSettings = &ClientSettings{}
 	}
@@ -112,14 +133,13 @@ func (v *Values) Default() error {
 			core.ResourceMemory: memory,
 		}
 	}
-	if v.HPA.MaxReplicas == 0 {
-		v.HPA.MaxReplicas = 1
-	}
-	if v.HPA.AverageCpuUtilization == nil {
-		v.HPA.AverageCpuUtilization = ptr.To(int32(80))
+	v.HPA.Default(1, 1)
+
+	if v.PodSecurityContext == nil {
+		v.PodSecurityContext = types.DefaultPodSecurityContext()
 	}
-	if v.HPA.AverageMemoryUtilization == nil {
-		v.HPA.AverageMemoryUtilization = ptr.To(int32(80))
+	if v.SecurityContext == nil {
+		v.SecurityContext = types.DefaultSecurityContext()
 	}
 
 	return nil
@@ -9,6 +9,7 @@ import (
 	"sigs.k8s.io/yaml"
 
 	"github.com/openmcp-project/service-provider-landscaper/internal/shared/identity"
+	"github.com/openmcp-project/service-provider-landscaper/internal/shared/kubeconfigs"
 )
 
 const (
@@ -25,6 +26,8 @@ type valuesHelper struct {
 	registrySecretsYaml []byte
 	registrySecretsHash string
 	registrySecretsData map[string][]byte
+
+	mcpKubeconfig *kubeconfigs.Secret
 }
 
 func newValuesHelper(values *Values) (*valuesHelper, error) {
@@ -59,7 +62,7 @@ func newValuesHelper(values *Values) (*valuesHelper, error) {
 		}
 	}
 
-	return &valuesHelper{
+	h := &valuesHelper{
 		values:                values,
 		helmDeployerComponent: identity.NewComponent(values.Instance, values.Version, componentHelmDeployer),
 		configYaml:            configYaml,
@@ -67,7 +70,12 @@ func newValuesHelper(values *Values) (*valuesHelper, error) {
 		registrySecretsYaml:   registrySecretsYaml,
 		registrySecretsHash:   hex.EncodeToString(registrySecretsHash[:]),
 		registrySecretsData:   registrySecretsData,
-	}, nil
+	}
+
+	if h.mcpKubeconfig, err = kubeconfigs.NewSecret(h.mcpClusterKubeconfig(), h.mcpKubeconfigSecretName()); err != nil {
+		return nil, err
+	}
+	return h, nil
 }
 
 func newValuesHelperForDelete(values *Values) (*valuesHelper, error) {
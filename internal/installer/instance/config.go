@@ -2,11 +2,14 @@ package instance
 
 import (
 	core "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/runtime"
 
 	"github.com/openmcp-project/controller-utils/pkg/clusters"
 
 	api "github.com/openmcp-project/service-provider-landscaper/api/v1alpha2"
 	"github.com/openmcp-project/service-provider-landscaper/internal/shared/identity"
+	"github.com/openmcp-project/service-provider-landscaper/internal/shared/sources"
 	"github.com/openmcp-project/service-provider-landscaper/internal/shared/types"
 )
 
@@ -14,23 +17,75 @@ type Configuration struct {
 	Instance identity.Instance
 	Version  string
 
-	PlatformCluster          *clusters.Cluster
-	PlatformClusterNamespace string
-	MCPCluster               *clusters.Cluster
-	WorkloadCluster          *clusters.Cluster
-	WorkloadClusterDomain    string
-	CaConfigMap              *core.ConfigMapKeySelector
+	// Sources resolves the references to the image pull secrets of the components.
+	Sources               *sources.Resolver
+	MCPCluster            *clusters.Cluster
+	WorkloadCluster       *clusters.Cluster
+	WorkloadClusterDomain string
+	CaConfigMap           *core.ConfigMapKeySelector
+	// Scheduling configures the scheduling of the pods of all components. It is nil if the defaults apply.
+	Scheduling *types.SchedulingValues
+	// VerticalScaling configures the vertical pod autoscalers of all components. It is nil if none are created.
+	VerticalScaling *types.VerticalScalingValues
+	// PodSecurityContext and SecurityContext replace the default security contexts of all components if set.
+	PodSecurityContext *core.PodSecurityContext
+	SecurityContext    *core.SecurityContext
+	// PodSecurityLevel is the Pod Security Standard which is enforced in the namespace of the instance on the workload cluster.
+	PodSecurityLevel string
+	// NetworkPolicies configures the network policies which isolate the namespace of the instance on the workload cluster.
+	// It is nil if no network policies are created.
+	NetworkPolicies *types.NetworkPolicyValues
+	// ResourceQuota configures the resource quota and limit range of the namespace of the instance on the workload cluster.
+	// It is nil if no resource quota is created.
+	ResourceQuota *ResourceQuotaConfig
 
 	Landscaper LandscaperConfig
 
 	ManifestDeployer ManifestDeployerConfig
 
 	HelmDeployer HelmDeployerConfig
+
+	// Deployers are the additional deployers to be installed.
+	Deployers []DeployerConfig
+
+	// RemovedDeployers are the names of additional deployers which are no longer selected and must be uninstalled.
+	RemovedDeployers []string
+}
+
+// installedDeployers returns the names of the deployers to be installed, including the helm and manifest deployer unless disabled.
+func (c *Configuration) installedDeployers() []string {
+	names := []string{}
+	if !c.ManifestDeployer.Disabled {
+		names = append(names, api.DeployerManifest)
+	}
+	if !c.HelmDeployer.Disabled {
+		names = append(names, api.DeployerHelm)
+	}
+	for _, d := range c.Deployers {
+		names = append(names, d.Name)
+	}
+	return names
+}
+
+// uninstalledDeployers returns the names of the removed deployers, including the helm and manifest deployer if disabled.
+func (c *Configuration) uninstalledDeployers() []string {
+	names := []string{}
+	if c.ManifestDeployer.Disabled {
+		names = append(names, api.DeployerManifest)
+	}
+	if c.HelmDeployer.Disabled {
+		names = append(names, api.DeployerHelm)
+	}
+	return append(names, c.RemovedDeployers...)
 }
 
 type LandscaperConfig struct {
 	Controller     ControllerConfig
 	WebhooksServer WebhooksServerConfig
+	// OCI configures the access to OCI registries. It is nil if no registries are configured.
+	OCI *OCIConfig
+	// OCICache configures the cache for OCI artifacts. It is nil if the defaults apply.
+	OCICache *OCICacheConfig
 }
 
 type ControllerConfig struct {
@@ -38,24 +93,84 @@ type ControllerConfig struct {
 	Resources     core.ResourceRequirements
 	ResourcesMain core.ResourceRequirements
 	HPAMain       types.HPAValues
+	// Configuration is the configuration of the controllers set in the Landscaper resource. It is nil if the defaults apply.
+	Configuration *api.ControllerConfiguration
 }
 
 type WebhooksServerConfig struct {
-	Image       api.ImageConfiguration
-	Resources   core.ResourceRequirements
-	HPA         types.HPAValues
-	ServiceName string
-	ServicePort int32
+	// DisableWebhooks lists the disabled webhooks. If it contains "all", the webhooks server is not installed.
+	DisableWebhooks []string
+	Image           api.ImageConfiguration
+	Resources       core.ResourceRequirements
+	HPA             types.HPAValues
+	ServiceName     string
+	ServicePort     int32
 }
 
 type ManifestDeployerConfig struct {
+	// Disabled skips the installation of the deployer, and uninstalls it if it has been installed before.
+	Disabled  bool
 	Image     api.ImageConfiguration
 	Resources core.ResourceRequirements
 	HPA       types.HPAValues
+	// Configuration is the configuration of the deployer set in the Landscaper resource. It is nil if the defaults apply.
+	Configuration *api.DeployerConfiguration
 }
 
 type HelmDeployerConfig struct {
+	// Disabled skips the installation of the deployer, and uninstalls it if it has been installed before.
+	Disabled  bool
+	Image     api.ImageConfiguration
+	Resources core.ResourceRequirements
+	HPA       types.HPAValues
+	// Configuration is the configuration of the deployer set in the Landscaper resource. It is nil if the defaults apply.
+	Configuration *api.DeployerConfiguration
+	// OCI configures the access to OCI registries. It is nil if no registries are configured.
+	OCI *OCIConfig
+}
+
+// ResourceQuotaConfig configures the resource quota and limit range of the namespace of an instance.
+type ResourceQuotaConfig struct {
+	// Headroom is added to the quota which is derived from the resource requests and maximum replicas of the components.
+	Headroom core.ResourceList
+	// DefaultRequest are the resource requests of containers without requests.
+	DefaultRequest core.ResourceList
+}
+
+// OCIConfig configures the access of the Landscaper or a deployer to OCI registries.
+type OCIConfig struct {
+	AllowPlainHTTP     bool
+	InsecureSkipVerify bool
+	// ConfigFiles maps file names to the content of docker config files with registry credentials.
+	ConfigFiles map[string][]byte
+}
+
+// OCICacheConfig configures the cache of the Landscaper for OCI artifacts.
+type OCICacheConfig struct {
+	UseInMemoryOverlay bool
+	SizeLimit          *resource.Quantity
+	Medium             core.StorageMedium
+	// Persistent backs the cache of the main controller with a persistent volume claim.
+	Persistent *PersistentCacheConfig
+}
+
+// PersistentCacheConfig configures the persistent volume claim of the cache.
+type PersistentCacheConfig struct {
+	StorageClassName *string
+	Size             resource.Quantity
+}
+
+// DeployerConfig is the configuration of an additional deployer, see api.DeployerOffering.
+type DeployerConfig struct {
+	// Name is the name of the deployer. The name api.DeployerContainer refers to the container deployer,
+	// any other name to a custom deployer. The mock deployer (api.DeployerMock) is installed as a custom deployer.
+	Name      string
 	Image     api.ImageConfiguration
 	Resources core.ResourceRequirements
 	HPA       types.HPAValues
+	// InitImage and WaitImage are the images of the init and wait container of the container deployer.
+	InitImage string
+	WaitImage string
+	// Configuration is the configuration of a custom deployer. The mock deployer has a default configuration.
+	Configuration *runtime.RawExtension
 }
@@ -0,0 +1,14 @@
+package instance
+
+import (
+	"context"
+	"time"
+
+	"github.com/openmcp-project/service-provider-landscaper/internal/installer/rbac"
+)
+
+// TokenRenewalTime returns the time at which the first token of the components for the MCP cluster must be renewed.
+// It returns the zero time if the instance has no tokens yet.
+func TokenRenewalTime(ctx context.Context, config *Configuration) (time.Time, error) {
+	return rbac.TokenRenewalTime(ctx, rbacValues(config))
+}
@@ -0,0 +1,104 @@
+package instance
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/openmcp-project/controller-utils/pkg/readiness"
+
+	api "github.com/openmcp-project/service-provider-landscaper/api/v1alpha2"
+	"github.com/openmcp-project/service-provider-landscaper/internal/installer/containerdeployer"
+	"github.com/openmcp-project/service-provider-landscaper/internal/installer/customdeployer"
+	"github.com/openmcp-project/service-provider-landscaper/internal/installer/rbac"
+)
+
+// installDeployer installs an additional deployer and returns the name of its deployment for the health checks.
+func installDeployer(ctx context.Context, c *Configuration, d *DeployerConfig, kubeconfigs *rbac.Kubeconfigs) (string, error) {
+	switch d.Name {
+	case api.DeployerContainer:
+		exports, err := containerdeployer.InstallContainerDeployer(ctx, containerDeployerValues(c, d, kubeconfigs))
+		if err != nil {
+			return "", err
+		}
+		return exports.DeploymentName, nil
+	default:
+		values, err := customDeployerValues(c, d, kubeconfigs)
+		if err != nil {
+			return "", err
+		}
+		exports, err := customdeployer.InstallCustomDeployer(ctx, values)
+		if err != nil {
+			return "", err
+		}
+		return exports.DeploymentName, nil
+	}
+}
+
+func uninstallDeployer(ctx context.Context, c *Configuration, d *DeployerConfig, kubeconfigs *rbac.Kubeconfigs) error {
+	switch d.Name {
+	case api.DeployerContainer:
+		return containerdeployer.UninstallContainerDeployer(ctx, containerDeployerValues(c, d, kubeconfigs))
+	default:
+		values, err := customDeployerValues(c, d, kubeconfigs)
+		if err != nil {
+			return err
+		}
+		return customdeployer.UninstallCustomDeployer(ctx, values)
+	}
+}
+
+func checkDeployerReadiness(ctx context.Context, c *Configuration, d *DeployerConfig, kubeconfigs *rbac.Kubeconfigs) readiness.CheckResult {
+	switch d.Name {
+	case api.DeployerContainer:
+		return containerdeployer.CheckReadiness(ctx, containerDeployerValues(c, d, kubeconfigs))
+	default:
+		values, err := customDeployerValues(c, d, kubeconfigs)
+		if err != nil {
+			return readiness.NewFailedResult(err)
+		}
+		return customdeployer.CheckReadiness(ctx, values)
+	}
+}
+
+// installDeployers installs the additional deployers.
+// It returns the names of the deployments of the installed deployers.
+func installDeployers(ctx context.Context, c *Configuration, kubeconfigs *rbac.Kubeconfigs) ([]string, error) {
+	deployments := []string{}
+	for i := range c.Deployers {
+		d := &c.Deployers[i]
+		deployment, err := installDeployer(ctx, c, d, kubeconfigs)
+		if err != nil {
+			return nil, fmt.Errorf("failed to install %s deployer: %w", d.Name, err)
+		}
+		deployments = append(deployments, deployment)
+	}
+	return deployments, nil
+}
+
+// uninstallDeployers uninstalls the selected and the removed additional deployers.
+func uninstallDeployers(ctx context.Context, c *Configuration, kubeconfigs *rbac.Kubeconfigs) error {
+	for i := range c.Deployers {
+		d := &c.Deployers[i]
+		if err := uninstallDeployer(ctx, c, d, kubeconfigs); err != nil {
+			return fmt.Errorf("failed to uninstall %s deployer: %w", d.Name, err)
+		}
+	}
+	return uninstallRemovedDeployers(ctx, c, kubeconfigs)
+}
+
+func uninstallRemovedDeployers(ctx context.Context, c *Configuration, kubeconfigs *rbac.Kubeconfigs) error {
+	for _, name := range c.RemovedDeployers {
+		if err := uninstallDeployer(ctx, c, &DeployerConfig{Name: name}, kubeconfigs); err != nil {
+			return fmt.Errorf("failed to uninstall removed %s deployer: %w", name, err)
+		}
+	}
+	return nil
+}
+
+func checkDeployersReadiness(ctx context.Context, c *Configuration, kubeconfigs *rbac.Kubeconfigs) []readiness.CheckResult {
+	results := []readiness.CheckResult{}
+	for i := range c.Deployers {
+		results = append(results, checkDeployerReadiness(ctx, c, &c.Deployers[i], kubeconfigs))
+	}
+	return results
+}
@@ -5,6 +5,8 @@ import (
 	"fmt"
 
 	"github.com/openmcp-project/controller-utils/pkg/readiness"
+	"github.com/openmcp-project/controller-utils/pkg/resources"
+	corev1 "k8s.io/api/core/v1"
 
 	"github.com/openmcp-project/service-provider-landscaper/internal/installer/helmdeployer"
 	"github.com/openmcp-project/service-provider-landscaper/internal/installer/landscaper"
@@ -13,31 +15,67 @@ import (
 )
 
 func InstallLandscaperInstance(ctx context.Context, config *Configuration) error {
+	// RBAC resources, with a service account for each component on the MCP cluster
+	err := rbac.InstallLandscaperRBACResources(ctx, rbacValues(config))
+	if err != nil {
+		return fmt.Errorf("failed to install landscaper rbac resources: %v", err)
+	}
+
+	// Namespace on the workload cluster with the enforced Pod Security Standard
+	err = resources.CreateOrUpdateResource(ctx, config.WorkloadCluster.Client(), namespaceMutator(config))
+	if err != nil {
+		return fmt.Errorf("failed to label namespace %s: %w", config.Instance.Namespace(), err)
+	}
+
+	// Kubeconfigs of the components, which are cached in the namespace on the workload cluster
 	kubeconfigs, err := rbac.GetKubeconfigs(ctx, rbacValues(config))
 	if err != nil {
 		return fmt.Errorf("failed to get kubeconfigs: %w", err)
 	}
 
-	// RBAC resources
-	err = rbac.InstallLandscaperRBACResources(ctx, rbacValues(config))
+	// Network policies isolating the namespace on the workload cluster
+	if err = installNetworkPolicies(ctx, config); err != nil {
+		return fmt.Errorf("failed to install network policies: %w", err)
+	}
+
+	// Resource quota and limit range of the namespace, sized for the components to be installed
+	if err = installResourceQuota(ctx, config); err != nil {
+		return fmt.Errorf("failed to install resource quota: %w", err)
+	}
+
+	// Disabled and removed deployers are uninstalled first, because they share image pull secrets with the other components,
+	// which are recreated by the subsequent installations.
+	err = uninstallDisabledDeployers(ctx, config, kubeconfigs)
 	if err != nil {
-		return fmt.Errorf("failed to install landscaper rbac resources: %v", err)
+		return err
 	}
 
 	// Manifest deployer
-	manifestExports, err := manifestdeployer.InstallManifestDeployer(ctx, manifestDeployerValues(config, kubeconfigs))
-	if err != nil {
-		return fmt.Errorf("failed to install manifest deployer: %w", err)
+	var manifestExports *manifestdeployer.Exports
+	if !config.ManifestDeployer.Disabled {
+		manifestExports, err = manifestdeployer.InstallManifestDeployer(ctx, manifestDeployerValues(config, kubeconfigs))
+		if err != nil {
+			return fmt.Errorf("failed to install manifest deployer: %w", err)
+		}
 	}
 
 	// Helm deployer
-	helmExports, err := helmdeployer.InstallHelmDeployer(ctx, helmDeployerValues(config, kubeconfigs))
+	var helmExports *helmdeployer.Exports
+	if !config.HelmDeployer.Disabled {
+		helmExports, err = helmdeployer.InstallHelmDeployer(ctx, helmDeployerValues(config, kubeconfigs))
+		if err != nil {
+			return fmt.Errorf("failed to install helm deployer: %w", err)
+		}
+	}
+
+	// Additional deployers
+	deployerDeployments, err := installDeployers(ctx, config, kubeconfigs)
 	if err != nil {
-		return fmt.Errorf("failed to install helm deployer: %w", err)
+		return err
 	}
 
 	// Landscaper
-	err = landscaper.InstallLandscaper(ctx, landscaperValues(config, kubeconfigs, manifestExports, helmExports))
+	err = landscaper.InstallLandscaper(ctx, landscaperValues(config, kubeconfigs, manifestExports, helmExports, deployerDeployments))
 	if err != nil {
 		return fmt.Errorf("failed to install landscaper controllers: %w", err)
 	}
@@ -45,17 +83,38 @@ func InstallLandscaperInstance(ctx context.Context, config *Configuration) error
 	return nil
 }
 
-func UninstallLandscaperInstance(ctx context.Context, config *Configuration) error {
-	kubeconfigs, err := rbac.GetKubeconfigs(ctx, rbacValues(config))
-	if err != nil {
-		return fmt.Errorf("failed to get kubeconfigs: %w", err)
+// uninstallDisabledDeployers uninstalls the helm and manifest deployer if they are disabled, as well as the additional
+// deployers which are no longer selected. The uninstallation tolerates resources which do not exist.
+func uninstallDisabledDeployers(ctx context.Context, config *Configuration, kubeconfigs *rbac.Kubeconfigs) error {
+	if config.ManifestDeployer.Disabled {
+		if err := manifestdeployer.UninstallManifestDeployer(ctx, manifestDeployerValues(config, kubeconfigs)); err != nil {
+			return fmt.Errorf("failed to uninstall disabled manifest deployer: %w", err)
+		}
 	}
 
-	err = landscaper.UninstallLandscaper(ctx, landscaperValues(config, kubeconfigs, nil, nil))
+	if config.HelmDeployer.Disabled {
+		if err := helmdeployer.UninstallHelmDeployer(ctx, helmDeployerValues(config, kubeconfigs)); err != nil {
+			return fmt.Errorf("failed to uninstall disabled helm deployer: %w", err)
+		}
+	}
+
+	return uninstallRemovedDeployers(ctx, config, kubeconfigs)
+}
+
+func UninstallLandscaperInstance(ctx context.Context, config *Configuration) error {
+	// the components are uninstalled without credentials
+	kubeconfigs := &rbac.Kubeconfigs{}
+
+	err := landscaper.UninstallLandscaper(ctx, landscaperValues(config, kubeconfigs, nil, nil, nil))
 	if err != nil {
 		return fmt.Errorf("failed to uninstall landscaper controllers: %w", err)
 	}
 
+	if err = uninstallDeployers(ctx, config, kubeconfigs); err != nil {
+		return err
+	}
+
+	// disabled deployers are uninstalled as well, because they might have been installed before
 	err = helmdeployer.UninstallHelmDeployer(ctx, helmDeployerValues(config, kubeconfigs))
 	if err != nil {
 		return fmt.Errorf("failed to uninstall helm deployer: %w", err)
@@ -66,6 +125,14 @@ func UninstallLandscaperInstance(ctx context.Context, config *Configuration) err
 		return fmt.Errorf("failed to uninstall manifest deployer: %w", err)
 	}
 
+	if err = uninstallResourceQuota(ctx, config); err != nil {
+		return fmt.Errorf("failed to uninstall resource quota: %w", err)
+	}
+
+	if err = uninstallNetworkPolicies(ctx, config); err != nil {
+		return fmt.Errorf("failed to uninstall network policies: %w", err)
+	}
+
 	err = rbac.UninstallLandscaperRBACResources(ctx, rbacValues(config))
 	if err != nil {
 		return fmt.Errorf("failed to uninstall landscaper rbac resources: %v", err)
@@ -75,9 +142,27 @@ func UninstallLandscaperInstance(ctx context.Context, config *Configuration) err
 
 func CheckReadiness(ctx context.Context, config *Configuration) readiness.CheckResult {
 	kubeconfigs := &rbac.Kubeconfigs{}
-	return readiness.Aggregate(
-		manifestdeployer.CheckReadiness(ctx, manifestDeployerValues(config, kubeconfigs)),
-		helmdeployer.CheckReadiness(ctx, helmDeployerValues(config, kubeconfigs)),
-		landscaper.CheckReadiness(ctx, landscaperValues(config, kubeconfigs, nil, nil)),
-	)
+	results := []readiness.CheckResult{}
+	if !config.ManifestDeployer.Disabled {
+		results = append(results, manifestdeployer.CheckReadiness(ctx, manifestDeployerValues(config, kubeconfigs)))
+	}
+	if !config.HelmDeployer.Disabled {
+		results = append(results, helmdeployer.CheckReadiness(ctx, helmDeployerValues(config, kubeconfigs)))
+	}
+	results = append(results, checkDeployersReadiness(ctx, config, kubeconfigs)...)
+	results = append(results, landscaper.CheckReadiness(ctx, landscaperValues(config, kubeconfigs, nil, nil, nil)))
+	return readiness.Aggregate(results...)
+}
+
+// labelPodSecurityEnforce is the label of a namespace with the enforced Pod Security Standard.
+const labelPodSecurityEnforce = "pod-security.kubernetes.io/enforce"
+
+// namespaceMutator returns the mutator of the namespace of the instance on the workload cluster,
+// which labels the namespace with the enforced Pod Security Standard.
+func namespaceMutator(config *Configuration) resources.Mutator[*corev1.Namespace] {
+	m := config.Instance.NamespaceMutator()
+	if config.PodSecurityLevel != "" {
+		m.MetadataMutator().WithLabels(map[string]string{labelPodSecurityEnforce: config.PodSecurityLevel})
+	}
+	return m
 }
@@ -16,12 +16,21 @@ import (
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
 	core "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	lsv1alpha2 "github.com/openmcp-project/service-provider-landscaper/api/v1alpha2"
+	"github.com/openmcp-project/service-provider-landscaper/internal/shared/identity"
 	"github.com/openmcp-project/service-provider-landscaper/internal/shared/types"
+	"github.com/openmcp-project/service-provider-landscaper/internal/shared/verticalscaling"
 )
 
 const (
@@ -41,6 +50,9 @@ func buildTestEnvironment(testdataDir string, objectsWithStatus ...client.Object
 	utilruntime.Must(clustersv1alpha1.AddToScheme(scheme))
 	utilruntime.Must(deploymentv1alpha1.AddToScheme(scheme))
 	utilruntime.Must(lsv1alpha2.AddToScheme(scheme))
+	// the vertical pod autoscalers are handled as unstructured objects
+	scheme.AddKnownTypeWithName(verticalscaling.GroupVersionKind, &unstructured.Unstructured{})
+	scheme.AddKnownTypeWithName(verticalscaling.GroupVersionKind.GroupVersion().WithKind("VerticalPodAutoscalerList"), &unstructured.UnstructuredList{})
 
 	return testutils.NewEnvironmentBuilder().
 		WithFakeClient(scheme).
@@ -123,6 +135,275 @@ var _ = Describe("Landscaper Instance Installer", func() {
 		Expect(err).NotTo(HaveOccurred())
 	})
 
+	It("should harden the pods of all components", func() {
+		env := buildTestEnvironment("test-01")
+		config := createConfiguration(env)
+		config.Instance = instanceID
+		config.WorkloadCluster = clusters.NewTestClusterFromClient("workload", env.Client())
+		config.MCPCluster = clusters.NewTestClusterFromClient("mcp", env.Client())
+		config.PodSecurityLevel = string(lsv1alpha2.PodSecurityLevelRestricted)
+
+		Expect(instance.InstallLandscaperInstance(env.Ctx, config)).To(Succeed())
+
+		namespace := &core.Namespace{}
+		Expect(env.Client().Get(env.Ctx, client.ObjectKey{Name: identity.Instance(instanceID).Namespace()}, namespace)).To(Succeed())
+		Expect(namespace.Labels).To(HaveKeyWithValue("pod-security.kubernetes.io/enforce", "restricted"))
+		// the namespace is labeled as managed by the provider, which the orphan collector relies on
+		Expect(namespace.Labels).To(HaveKeyWithValue("app.kubernetes.io/managed-by", "landscaper-provider"))
+
+		for _, name := range []string{"landscaper-controller", "landscaper-controller-main", "landscaper-webhooks-server", "manifest-deployer", "helm-deployer"} {
+			deployment := &appsv1.Deployment{}
+			Expect(env.Client().Get(env.Ctx, client.ObjectKey{Name: name, Namespace: namespace.Name}, deployment)).To(Succeed())
+			podSpec := deployment.Spec.Template.Spec
+			Expect(podSpec.SecurityContext).To(Equal(types.DefaultPodSecurityContext()))
+			Expect(podSpec.Containers[0].SecurityContext).To(Equal(types.DefaultSecurityContext()))
+			Expect(podSpec.Volumes).To(ContainElement(HaveField("Name", types.TmpVolumeName)))
+			Expect(podSpec.Containers[0].VolumeMounts).To(ContainElement(types.TmpVolumeMount()))
+		}
+
+		// the provider config can replace the security contexts
+		config.SecurityContext = &core.SecurityContext{RunAsUser: ptr.To[int64](1000)}
+		config.PodSecurityLevel = string(lsv1alpha2.PodSecurityLevelBaseline)
+		Expect(instance.InstallLandscaperInstance(env.Ctx, config)).To(Succeed())
+
+		Expect(env.Client().Get(env.Ctx, client.ObjectKeyFromObject(namespace), namespace)).To(Succeed())
+		Expect(namespace.Labels).To(HaveKeyWithValue("pod-security.kubernetes.io/enforce", "baseline"))
+		deployment := &appsv1.Deployment{}
+		Expect(env.Client().Get(env.Ctx, client.ObjectKey{Name: "helm-deployer", Namespace: namespace.Name}, deployment)).To(Succeed())
+		Expect(deployment.Spec.Template.Spec.SecurityContext).To(Equal(types.DefaultPodSecurityContext()))
+		Expect(deployment.Spec.Template.Spec.Containers[0].SecurityContext).To(Equal(config.SecurityContext))
+	})
+
+	It("should install the mock deployer as a custom deployer with its default configuration", func() {
+		env := buildTestEnvironment("test-01")
+		config := createConfiguration(env)
+		config.Instance = instanceID
+		config.WorkloadCluster = clusters.NewTestClusterFromClient("workload", env.Client())
+		config.MCPCluster = clusters.NewTestClusterFromClient("mcp", env.Client())
+
+		providerConfig := lsv1alpha2.ProviderConfig{}
+		Expect(env.Client().Get(env.Ctx, client.ObjectKey{Name: "default"}, &providerConfig)).To(Succeed())
+		config.Deployers = []instance.DeployerConfig{
+			{
+				Name: lsv1alpha2.DeployerMock,
+				Image: lsv1alpha2.ImageConfiguration{
+					Image: providerConfig.GetDeployerImageLocation(&lsv1alpha2.DeployerOffering{Name: lsv1alpha2.DeployerMock}, version),
+				},
+			},
+		}
+
+		Expect(instance.InstallLandscaperInstance(env.Ctx, config)).To(Succeed())
+
+		namespace := identity.Instance(instanceID).Namespace()
+		deployment := &appsv1.Deployment{}
+		Expect(env.Client().Get(env.Ctx, client.ObjectKey{Name: "mock-deployer", Namespace: namespace}, deployment)).To(Succeed())
+		Expect(deployment.Spec.Template.Spec.Containers[0].Image).To(Equal("registry.test/components/" + lsv1alpha2.MockDeployerImageLocation + ":" + version))
+
+		configSecret := &core.Secret{}
+		Expect(env.Client().Get(env.Ctx, client.ObjectKey{Name: "mock-deployer-config", Namespace: namespace}, configSecret)).To(Succeed())
+		Expect(string(configSecret.Data["config.yaml"])).To(ContainSubstring("kind: Configuration"))
+		Expect(string(configSecret.Data["config.yaml"])).To(ContainSubstring("identity: mock-deployer-" + instanceID))
+
+		// a configuration in the offering replaces the default configuration
+		config.Deployers[0].Configuration = &runtime.RawExtension{Raw: []byte(`{"identity":"custom-mock"}`)}
+		Expect(instance.InstallLandscaperInstance(env.Ctx, config)).To(Succeed())
+
+		Expect(env.Client().Get(env.Ctx, client.ObjectKeyFromObject(configSecret), configSecret)).To(Succeed())
+		Expect(string(configSecret.Data["config.yaml"])).To(Equal("identity: custom-mock\n"))
+	})
+
+	It("should isolate the namespace of the instance with network policies", func() {
+		env := buildTestEnvironment("test-01")
+		config := createConfiguration(env)
+		config.Instance = instanceID
+		config.WorkloadCluster = clusters.NewTestClusterFromClient("workload", env.Client())
+		config.MCPCluster = clusters.NewTestClusterFromClient("mcp", env.Client())
+		config.Landscaper.WebhooksServer.ServicePort = 9443
+		gateway := networkingv1.NetworkPolicyPeer{
+			NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"kubernetes.io/metadata.name": "openmcp-system"}},
+		}
+		dns := networkingv1.NetworkPolicyEgressRule{
+			Ports: []networkingv1.NetworkPolicyPort{{Protocol: ptr.To(core.ProtocolUDP), Port: ptr.To(intstr.FromInt32(53))}},
+		}
+		config.NetworkPolicies = &types.NetworkPolicyValues{
+			Gateway: []networkingv1.NetworkPolicyPeer{gateway},
+			Egress:  []networkingv1.NetworkPolicyEgressRule{dns},
+		}
+
+		Expect(instance.InstallLandscaperInstance(env.Ctx, config)).To(Succeed())
+
+		namespace := identity.Instance(instanceID).Namespace()
+		defaultDeny := &networkingv1.NetworkPolicy{}
+		Expect(env.Client().Get(env.Ctx, client.ObjectKey{Name: "default-deny-all", Namespace: namespace}, defaultDeny)).To(Succeed())
+		Expect(defaultDeny.Spec.PodSelector).To(Equal(metav1.LabelSelector{}))
+		Expect(defaultDeny.Spec.PolicyTypes).To(ConsistOf(networkingv1.PolicyTypeIngress, networkingv1.PolicyTypeEgress))
+		Expect(defaultDeny.Spec.Ingress).To(BeEmpty())
+		Expect(defaultDeny.Spec.Egress).To(BeEmpty())
+
+		egress := &networkingv1.NetworkPolicy{}
+		Expect(env.Client().Get(env.Ctx, client.ObjectKey{Name: "allow-egress", Namespace: namespace}, egress)).To(Succeed())
+		Expect(egress.Spec.Egress).To(Equal([]networkingv1.NetworkPolicyEgressRule{dns}))
+
+		// without additional ingress rules, no ingress is allowed except for the webhooks server
+		err := env.Client().Get(env.Ctx, client.ObjectKey{Name: "allow-ingress", Namespace: namespace}, &networkingv1.NetworkPolicy{})
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+
+		webhooks := &networkingv1.NetworkPolicy{}
+		Expect(env.Client().Get(env.Ctx, client.ObjectKey{Name: "landscaper-webhooks-server", Namespace: namespace}, webhooks)).To(Succeed())
+		Expect(webhooks.Spec.PodSelector.MatchLabels).To(HaveKeyWithValue("app.kubernetes.io/component", "landscaper-webhooks-server"))
+		Expect(webhooks.Spec.Ingress).To(HaveLen(1))
+		Expect(webhooks.Spec.Ingress[0].From).To(ConsistOf(gateway))
+		Expect(webhooks.Spec.Ingress[0].Ports).To(ConsistOf(networkingv1.NetworkPolicyPort{Protocol: ptr.To(core.ProtocolTCP), Port: ptr.To(intstr.FromInt32(9443))}))
+
+		// disabling the network policies removes them
+		config.NetworkPolicies = nil
+		Expect(instance.InstallLandscaperInstance(env.Ctx, config)).To(Succeed())
+		policies := &networkingv1.NetworkPolicyList{}
+		Expect(env.Client().List(env.Ctx, policies, client.InNamespace(namespace))).To(Succeed())
+		Expect(policies.Items).To(BeEmpty())
+	})
+
+	It("should limit the resources of the namespace of the instance", func() {
+		env := buildTestEnvironment("test-01")
+		config := createConfiguration(env)
+		config.Instance = instanceID
+		config.WorkloadCluster = clusters.NewTestClusterFromClient("workload", env.Client())
+		config.MCPCluster = clusters.NewTestClusterFromClient("mcp", env.Client())
+		config.HelmDeployer.HPA = types.HPAValues{MaxReplicas: 3}
+		config.ResourceQuota = &instance.ResourceQuotaConfig{
+			Headroom: core.ResourceList{core.ResourcePods: resource.MustParse("2")},
+			DefaultRequest: core.ResourceList{
+				core.ResourceCPU:    resource.MustParse("10m"),
+				core.ResourceMemory: resource.MustParse("32Mi"),
+			},
+		}
+
+		Expect(instance.InstallLandscaperInstance(env.Ctx, config)).To(Succeed())
+
+		// each component is counted with its maximum number of replicas plus one for rolling updates:
+		// manifest deployer 2 x 100m, helm deployer 4 x 300m, central controller 2 x 100m,
+		// main controller 2 x 300m, webhooks server 3 x 100m
+		namespace := identity.Instance(instanceID).Namespace()
+		quota := &core.ResourceQuota{}
+		Expect(env.Client().Get(env.Ctx, client.ObjectKey{Name: "landscaper", Namespace: namespace}, quota)).To(Succeed())
+		Expect(quota.Spec.Hard).To(HaveLen(3))
+		Expect(quota.Spec.Hard.Pods().Value()).To(Equal(int64(15)))
+		Expect(quota.Spec.Hard.Name(core.ResourceRequestsCPU, resource.DecimalSI).MilliValue()).To(Equal(int64(2500)))
+		Expect(quota.Spec.Hard.Name(core.ResourceRequestsMemory, resource.BinarySI).Equal(resource.MustParse("2500Mi"))).To(BeTrue())
+
+		limitRange := &core.LimitRange{}
+		Expect(env.Client().Get(env.Ctx, client.ObjectKey{Name: "landscaper", Namespace: namespace}, limitRange)).To(Succeed())
+		Expect(limitRange.Spec.Limits).To(HaveLen(1))
+		Expect(limitRange.Spec.Limits[0].DefaultRequest).To(Equal(config.ResourceQuota.DefaultRequest))
+
+		// the quota follows the sizing of the components
+		config.HelmDeployer.HPA = types.HPAValues{MaxReplicas: 1}
+		Expect(instance.InstallLandscaperInstance(env.Ctx, config)).To(Succeed())
+		Expect(env.Client().Get(env.Ctx, client.ObjectKeyFromObject(quota), quota)).To(Succeed())
+		Expect(quota.Spec.Hard.Pods().Value()).To(Equal(int64(13)))
+		Expect(quota.Spec.Hard.Name(core.ResourceRequestsCPU, resource.DecimalSI).MilliValue()).To(Equal(int64(1900)))
+
+		// an exhausted quota is reported
+		message, err := instance.ExhaustedResourceQuota(env.Ctx, config)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(message).To(BeEmpty())
+		quota.Status = core.ResourceQuotaStatus{
+			Hard: quota.Spec.Hard,
+			Used: core.ResourceList{core.ResourcePods: resource.MustParse("13"), core.ResourceRequestsCPU: resource.MustParse("1")},
+		}
+		Expect(env.Client().Update(env.Ctx, quota)).To(Succeed())
+		message, err = instance.ExhaustedResourceQuota(env.Ctx, config)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(message).To(Equal("resource quota of namespace " + namespace + " is exhausted: pods (used 13, hard 13)"))
+
+		// disabling the resource quota removes it
+		config.ResourceQuota = nil
+		Expect(instance.InstallLandscaperInstance(env.Ctx, config)).To(Succeed())
+		err = env.Client().Get(env.Ctx, client.ObjectKeyFromObject(quota), quota)
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		err = env.Client().Get(env.Ctx, client.ObjectKeyFromObject(limitRange), limitRange)
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+	})
+
+	It("should create vertical pod autoscalers and read their recommendations", func() {
+		env := buildTestEnvironment("test-01")
+		config := createConfiguration(env)
+		config.Instance = instanceID
+		config.WorkloadCluster = clusters.NewTestClusterFromClient("workload", env.Client())
+		config.MCPCluster = clusters.NewTestClusterFromClient("mcp", env.Client())
+		config.VerticalScaling = &types.VerticalScalingValues{
+			UpdateMode: string(lsv1alpha2.VerticalScalingUpdateModeOff),
+			MaxAllowed: core.ResourceList{core.ResourceMemory: resource.MustParse("1Gi")},
+		}
+
+		Expect(instance.InstallLandscaperInstance(env.Ctx, config)).To(Succeed())
+
+		nestedString := func(obj map[string]interface{}, fields ...string) string {
+			value, _, err := unstructured.NestedString(obj, fields...)
+			Expect(err).NotTo(HaveOccurred())
+			return value
+		}
+
+		namespace := identity.Instance(instanceID).Namespace()
+		components := []string{"landscaper-controller", "landscaper-controller-main", "landscaper-webhooks-server", "manifest-deployer", "helm-deployer"}
+		for _, name := range components {
+			vpa := &unstructured.Unstructured{}
+			vpa.SetGroupVersionKind(verticalscaling.GroupVersionKind)
+			Expect(env.Client().Get(env.Ctx, client.ObjectKey{Name: name, Namespace: namespace}, vpa)).To(Succeed())
+			Expect(nestedString(vpa.Object, "spec", "targetRef", "name")).To(Equal(name))
+			Expect(nestedString(vpa.Object, "spec", "updatePolicy", "updateMode")).To(Equal("Off"))
+			policies, _, err := unstructured.NestedSlice(vpa.Object, "spec", "resourcePolicy", "containerPolicies")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(policies).To(HaveLen(1))
+			Expect(nestedString(policies[0].(map[string]interface{}), "maxAllowed", "memory")).To(Equal("1Gi"))
+		}
+
+		recommendations, err := instance.ResourceRecommendations(env.Ctx, config)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(recommendations).To(BeEmpty())
+
+		// the recommendations are read from the status of the vertical pod autoscalers
+		vpa := &unstructured.Unstructured{}
+		vpa.SetGroupVersionKind(verticalscaling.GroupVersionKind)
+		Expect(env.Client().Get(env.Ctx, client.ObjectKey{Name: "helm-deployer", Namespace: namespace}, vpa)).To(Succeed())
+		Expect(unstructured.SetNestedSlice(vpa.Object, []interface{}{
+			map[string]interface{}{
+				"containerName": "helm-deployer",
+				"target":        map[string]interface{}{"cpu": "25m", "memory": "120Mi"},
+				"lowerBound":    map[string]interface{}{"cpu": "15m", "memory": "100Mi"},
+				"upperBound":    map[string]interface{}{"cpu": "80m", "memory": "300Mi"},
+			},
+		}, "status", "recommendation", "containerRecommendations")).To(Succeed())
+		Expect(env.Client().Update(env.Ctx, vpa)).To(Succeed())
+
+		recommendations, err = instance.ResourceRecommendations(env.Ctx, config)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(recommendations).To(HaveLen(1))
+		Expect(recommendations[0].Component).To(Equal("helm-deployer"))
+		Expect(recommendations[0].Container).To(Equal("helm-deployer"))
+		Expect(recommendations[0].Target.Cpu().Equal(resource.MustParse("25m"))).To(BeTrue())
+		Expect(recommendations[0].UpperBound.Memory().Equal(resource.MustParse("300Mi"))).To(BeTrue())
+
+		// the recommendations are only applied to components which are not scaled horizontally
+		config.VerticalScaling.UpdateMode = "Recreate"
+		config.HelmDeployer.HPA = types.HPAValues{MinReplicas: ptr.To[int32](1), MaxReplicas: 3}
+		Expect(instance.InstallLandscaperInstance(env.Ctx, config)).To(Succeed())
+		for name, updateMode := range map[string]string{"helm-deployer": "Off", "manifest-deployer": "Recreate", "landscaper-webhooks-server": "Recreate"} {
+			vpa := &unstructured.Unstructured{}
+			vpa.SetGroupVersionKind(verticalscaling.GroupVersionKind)
+			Expect(env.Client().Get(env.Ctx, client.ObjectKey{Name: name, Namespace: namespace}, vpa)).To(Succeed())
+			Expect(nestedString(vpa.Object, "spec", "updatePolicy", "updateMode")).To(Equal(updateMode), name)
+		}
+
+		// without vertical scaling, the vertical pod autoscalers are removed
+		config.VerticalScaling = nil
+		Expect(instance.InstallLandscaperInstance(env.Ctx, config)).To(Succeed())
+		list := &unstructured.UnstructuredList{}
+		list.SetGroupVersionKind(verticalscaling.GroupVersionKind.GroupVersion().WithKind("VerticalPodAutoscalerList"))
+		Expect(env.Client().List(env.Ctx, list, client.InNamespace(namespace))).To(Succeed())
+		Expect(list.Items).To(BeEmpty())
+	})
+
 	It("should uninstall the landscaper instance", func() {
 		var err error
 
@@ -0,0 +1,109 @@
+package instance
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/openmcp-project/controller-utils/pkg/resources"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/openmcp-project/service-provider-landscaper/internal/shared/identity"
+)
+
+const (
+	// networkPolicyDefaultDeny is the name of the network policy which denies all traffic of the pods in the namespace of the instance.
+	networkPolicyDefaultDeny = "default-deny-all"
+	// networkPolicyAllowEgress and networkPolicyAllowIngress are the names of the network policies with the allowed traffic.
+	networkPolicyAllowEgress  = "allow-egress"
+	networkPolicyAllowIngress = "allow-ingress"
+)
+
+type networkPolicyMutator struct {
+	name      string
+	namespace string
+	// spec is the spec of the network policy. It is nil if the network policy is not required and must be deleted.
+	spec     *networkingv1.NetworkPolicySpec
+	metadata resources.MetadataMutator
+}
+
+var _ resources.Mutator[*networkingv1.NetworkPolicy] = &networkPolicyMutator{}
+
+// installNetworkPolicies creates the network policies which isolate the namespace of the instance on the workload cluster,
+// and deletes those which are not required. The network policy of the webhooks server is created by its installer.
+func installNetworkPolicies(ctx context.Context, config *Configuration) error {
+	for _, m := range networkPolicyMutators(config) {
+		if m.spec != nil {
+			if err := resources.CreateOrUpdateResource(ctx, config.WorkloadCluster.Client(), m); err != nil {
+				return err
+			}
+		} else if err := resources.DeleteResource(ctx, config.WorkloadCluster.Client(), m); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// uninstallNetworkPolicies deletes the network policies in the namespace of the instance on the workload cluster.
+func uninstallNetworkPolicies(ctx context.Context, config *Configuration) error {
+	for _, m := range networkPolicyMutators(config) {
+		if err := resources.DeleteResource(ctx, config.WorkloadCluster.Client(), m); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// networkPolicyMutators returns the mutators of the network policies in the namespace of the instance.
+// The default deny policy applies to all pods of the namespace, so that only the allowed traffic remains.
+func networkPolicyMutators(config *Configuration) []*networkPolicyMutator {
+	namespace := config.Instance.Namespace()
+	defaultDeny := &networkPolicyMutator{name: networkPolicyDefaultDeny, namespace: namespace, metadata: resources.NewMetadataMutator()}
+	egress := &networkPolicyMutator{name: networkPolicyAllowEgress, namespace: namespace, metadata: resources.NewMetadataMutator()}
+	ingress := &networkPolicyMutator{name: networkPolicyAllowIngress, namespace: namespace, metadata: resources.NewMetadataMutator()}
+
+	if v := config.NetworkPolicies; v != nil {
+		defaultDeny.spec = &networkingv1.NetworkPolicySpec{
+			PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress, networkingv1.PolicyTypeEgress},
+		}
+		if len(v.Egress) > 0 {
+			egress.spec = &networkingv1.NetworkPolicySpec{
+				PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeEgress},
+				Egress:      v.Egress,
+			}
+		}
+		if len(v.Ingress) > 0 {
+			ingress.spec = &networkingv1.NetworkPolicySpec{
+				PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress},
+				Ingress:     v.Ingress,
+			}
+		}
+	}
+
+	return []*networkPolicyMutator{defaultDeny, egress, ingress}
+}
+
+func (m *networkPolicyMutator) String() string {
+	return fmt.Sprintf("networkpolicy %s/%s", m.namespace, m.name)
+}
+
+func (m *networkPolicyMutator) Empty() *networkingv1.NetworkPolicy {
+	return &networkingv1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      m.name,
+			Namespace: m.namespace,
+		},
+	}
+}
+
+func (m *networkPolicyMutator) MetadataMutator() resources.MetadataMutator {
+	return m.metadata
+}
+
+func (m *networkPolicyMutator) Mutate(r *networkingv1.NetworkPolicy) error {
+	r.Labels = identity.ManagedByLabels()
+	if m.spec != nil {
+		r.Spec = *m.spec
+	}
+	return nil
+}
@@ -0,0 +1,255 @@
+package instance
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/openmcp-project/controller-utils/pkg/resources"
+	core "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	api "github.com/openmcp-project/service-provider-landscaper/api/v1alpha2"
+	"github.com/openmcp-project/service-provider-landscaper/internal/installer/rbac"
+	"github.com/openmcp-project/service-provider-landscaper/internal/shared/identity"
+	"github.com/openmcp-project/service-provider-landscaper/internal/shared/types"
+)
+
+// resourceQuotaName is the name of the resource quota and the limit range in the namespace of the instance.
+const resourceQuotaName = "landscaper"
+
+// installResourceQuota creates or updates the resource quota and limit range of the namespace of the instance,
+// or deletes them if no resource quota is configured. It is called before the components are installed,
+// so that the quota already admits their new sizing.
+func installResourceQuota(ctx context.Context, config *Configuration) error {
+	workloadClient := config.WorkloadCluster.Client()
+	if config.ResourceQuota == nil {
+		return uninstallResourceQuota(ctx, config)
+	}
+
+	hard, err := resourceQuotaHard(config)
+	if err != nil {
+		return fmt.Errorf("failed to compute resource quota: %w", err)
+	}
+	if err := resources.CreateOrUpdateResource(ctx, workloadClient, newResourceQuotaMutator(config, hard)); err != nil {
+		return err
+	}
+	return resources.CreateOrUpdateResource(ctx, workloadClient, newLimitRangeMutator(config))
+}
+
+// uninstallResourceQuota deletes the resource quota and limit range of the namespace of the instance.
+func uninstallResourceQuota(ctx context.Context, config *Configuration) error {
+	workloadClient := config.WorkloadCluster.Client()
+	if err := resources.DeleteResource(ctx, workloadClient, newResourceQuotaMutator(config, nil)); err != nil {
+		return err
+	}
+	return resources.DeleteResource(ctx, workloadClient, newLimitRangeMutator(config))
+}
+
+// resourceQuotaHard derives the hard limits of the resource quota from the resource requests and the maximum number
+// of replicas of all installed components. Each component gets one additional replica for the surge of a rolling update.
+// If vertical pod autoscalers apply their recommendations, the requests are raised to their upper bound.
+// The headroom of the configuration is added to the result.
+func resourceQuotaHard(config *Configuration) (core.ResourceList, error) {
+	kubeconfigs := &rbac.Kubeconfigs{}
+	hard := core.ResourceList{}
+	add := func(requests core.ResourceList, maxReplicas int32) {
+		replicas := int64(maxReplicas) + 1
+		addQuantity(hard, core.ResourcePods, *resource.NewQuantity(replicas, resource.DecimalSI))
+		for name, q := range maxRequests(requests, config.VerticalScaling) {
+			q = q.DeepCopy()
+			q.Mul(replicas)
+			addQuantity(hard, core.ResourceName("requests."+string(name)), q)
+		}
+	}
+
+	if !config.ManifestDeployer.Disabled {
+		v := manifestDeployerValues(config, kubeconfigs)
+		if err := v.Default(); err != nil {
+			return nil, err
+		}
+		add(v.Resources.Requests, v.HPA.MaxReplicas)
+	}
+
+	if !config.HelmDeployer.Disabled {
+		v := helmDeployerValues(config, kubeconfigs)
+		if err := v.Default(); err != nil {
+			return nil, err
+		}
+		add(v.Resources.Requests, v.HPA.MaxReplicas)
+	}
+
+	for i := range config.Deployers {
+		d := &config.Deployers[i]
+		switch d.Name {
+		case api.DeployerContainer:
+			v := containerDeployerValues(config, d, kubeconfigs)
+			if err := v.Default(); err != nil {
+				return nil, err
+			}
+			add(v.Resources.Requests, v.HPA.MaxReplicas)
+		default:
+			v, err := customDeployerValues(config, d, kubeconfigs)
+			if err != nil {
+				return nil, err
+			}
+			if err := v.Default(); err != nil {
+				return nil, err
+			}
+			add(v.Resources.Requests, v.HPA.MaxReplicas)
+		}
+	}
+
+	v := landscaperValues(config, kubeconfigs, nil, nil, nil)
+	if err := v.Default(); err != nil {
+		return nil, err
+	}
+	add(v.Controller.Resources.Requests, 1)
+	add(v.Controller.ResourcesMain.Requests, v.Controller.HPAMain.MaxReplicas)
+	if !slices.Contains(v.WebhooksServer.DisableWebhooks, string(api.WebhookAll)) {
+		add(v.WebhooksServer.Resources.Requests, v.WebhooksServer.HPA.MaxReplicas)
+	}
+
+	for name, q := range config.ResourceQuota.Headroom {
+		addQuantity(hard, name, q)
+	}
+	return hard, nil
+}
+
+// maxRequests returns the resource requests of a component, raised to the upper bound of its vertical pod autoscaler
+// if the recommendations are applied to the pods.
+func maxRequests(requests core.ResourceList, vs *types.VerticalScalingValues) core.ResourceList {
+	if vs == nil || vs.UpdateMode == string(api.VerticalScalingUpdateModeOff) {
+		return requests
+	}
+	result := requests.DeepCopy()
+	for name, q := range vs.MaxAllowed {
+		if r, ok := result[name]; ok && q.Cmp(r) > 0 {
+			result[name] = q
+		}
+	}
+	return result
+}
+
+func addQuantity(list core.ResourceList, name core.ResourceName, q resource.Quantity) {
+	sum := list[name]
+	sum.Add(q)
+	list[name] = sum
+}
+
+// ExhaustedResourceQuota returns a message which lists the resources whose quota in the namespace of the instance
+// is used up, according to the status of the resource quota. It returns an empty string if no resource is exhausted,
+// or if no resource quota is configured.
+func ExhaustedResourceQuota(ctx context.Context, config *Configuration) (string, error) {
+	if config.ResourceQuota == nil {
+		return "", nil
+	}
+
+	quota := &core.ResourceQuota{}
+	err := config.WorkloadCluster.Client().Get(ctx, client.ObjectKey{Name: resourceQuotaName, Namespace: config.Instance.Namespace()}, quota)
+	if apierrors.IsNotFound(err) {
+		return "", nil
+	} else if err != nil {
+		return "", fmt.Errorf("failed to get resource quota: %w", err)
+	}
+
+	exhausted := []string{}
+	for name, hard := range quota.Status.Hard {
+		if used, ok := quota.Status.Used[name]; ok && used.Cmp(hard) >= 0 {
+			exhausted = append(exhausted, fmt.Sprintf("%s (used %s, hard %s)", name, used.String(), hard.String()))
+		}
+	}
+	if len(exhausted) == 0 {
+		return "", nil
+	}
+	sort.Strings(exhausted)
+	return fmt.Sprintf("resource quota of namespace %s is exhausted: %s", quota.Namespace, strings.Join(exhausted, ", ")), nil
+}
+
+type resourceQuotaMutator struct {
+	namespace string
+	hard      core.ResourceList
+	metadata  resources.MetadataMutator
+}
+
+var _ resources.Mutator[*core.ResourceQuota] = &resourceQuotaMutator{}
+
+func newResourceQuotaMutator(config *Configuration, hard core.ResourceList) resources.Mutator[*core.ResourceQuota] {
+	return &resourceQuotaMutator{namespace: config.Instance.Namespace(), hard: hard, metadata: resources.NewMetadataMutator()}
+}
+
+func (m *resourceQuotaMutator) String() string {
+	return fmt.Sprintf("resourcequota %s/%s", m.namespace, resourceQuotaName)
+}
+
+func (m *resourceQuotaMutator) Empty() *core.ResourceQuota {
+	return &core.ResourceQuota{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      resourceQuotaName,
+			Namespace: m.namespace,
+		},
+	}
+}
+
+func (m *resourceQuotaMutator) MetadataMutator() resources.MetadataMutator {
+	return m.metadata
+}
+
+func (m *resourceQuotaMutator) Mutate(r *core.ResourceQuota) error {
+	r.Labels = identity.ManagedByLabels()
+	r.Spec = core.ResourceQuotaSpec{Hard: m.hard}
+	return nil
+}
+
+type limitRangeMutator struct {
+	namespace      string
+	defaultRequest core.ResourceList
+	metadata       resources.MetadataMutator
+}
+
+var _ resources.Mutator[*core.LimitRange] = &limitRangeMutator{}
+
+// newLimitRangeMutator returns the mutator of the limit range, which sets the resource requests of containers
+// without requests, so that they are admitted by the resource quota.
+func newLimitRangeMutator(config *Configuration) resources.Mutator[*core.LimitRange] {
+	m := &limitRangeMutator{namespace: config.Instance.Namespace(), metadata: resources.NewMetadataMutator()}
+	if config.ResourceQuota != nil {
+		m.defaultRequest = config.ResourceQuota.DefaultRequest
+	}
+	return m
+}
+
+func (m *limitRangeMutator) String() string {
+	return fmt.Sprintf("limitrange %s/%s", m.namespace, resourceQuotaName)
+}
+
+func (m *limitRangeMutator) Empty() *core.LimitRange {
+	return &core.LimitRange{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      resourceQuotaName,
+			Namespace: m.namespace,
+		},
+	}
+}
+
+func (m *limitRangeMutator) MetadataMutator() resources.MetadataMutator {
+	return m.metadata
+}
+
+func (m *limitRangeMutator) Mutate(r *core.LimitRange) error {
+	r.Labels = identity.ManagedByLabels()
+	r.Spec = core.LimitRangeSpec{
+		Limits: []core.LimitRangeItem{
+			{
+				Type:           core.LimitTypeContainer,
+				DefaultRequest: m.defaultRequest,
+			},
+		},
+	}
+	return nil
+}
@@ -1,38 +1,71 @@
 package instance
 
 import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
 	"github.com/openmcp-project/landscaper/apis/config/v1alpha1"
+	lscore "github.com/openmcp-project/landscaper/apis/core/v1alpha1"
+	helmv1alpha1 "github.com/openmcp-project/landscaper/apis/deployer/helm/v1alpha1"
+	manifestv1alpha2 "github.com/openmcp-project/landscaper/apis/deployer/manifest/v1alpha2"
+	mockv1alpha1 "github.com/openmcp-project/landscaper/apis/deployer/mock/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/selection"
 	"k8s.io/utils/ptr"
 
+	api "github.com/openmcp-project/service-provider-landscaper/api/v1alpha2"
+	"github.com/openmcp-project/service-provider-landscaper/internal/installer/containerdeployer"
+	"github.com/openmcp-project/service-provider-landscaper/internal/installer/customdeployer"
 	"github.com/openmcp-project/service-provider-landscaper/internal/installer/helmdeployer"
 	"github.com/openmcp-project/service-provider-landscaper/internal/installer/landscaper"
 	"github.com/openmcp-project/service-provider-landscaper/internal/installer/manifestdeployer"
 	"github.com/openmcp-project/service-provider-landscaper/internal/installer/rbac"
+	"github.com/openmcp-project/service-provider-landscaper/internal/shared/identity"
 )
 
 // rbacValues determines the import values for the installation of the rbac resources
 func rbacValues(c *Configuration) *rbac.Values {
 	return &rbac.Values{
-		Instance:        c.Instance,
-		Version:         c.Version,
-		MCPCluster:      c.MCPCluster,
-		WorkloadCluster: c.WorkloadCluster,
+		Instance:         c.Instance,
+		Version:          c.Version,
+		MCPCluster:       c.MCPCluster,
+		WorkloadCluster:  c.WorkloadCluster,
+		Deployers:        c.installedDeployers(),
+		RemovedDeployers: c.uninstalledDeployers(),
 	}
 }
 
 // manifestDeployerValues determines the import values for the installation of the manifest deployer
 func manifestDeployerValues(c *Configuration, kubeconfigs *rbac.Kubeconfigs) *manifestdeployer.Values {
 	v := &manifestdeployer.Values{
-		Instance:                 c.Instance,
-		Version:                  c.Version,
-		PlatformCluster:          c.PlatformCluster,
-		PlatformClusterNamespace: c.PlatformClusterNamespace,
-		WorkloadCluster:          c.WorkloadCluster,
-		Image:                    c.ManifestDeployer.Image,
-		Resources:                c.ManifestDeployer.Resources,
-		HPA:                      c.ManifestDeployer.HPA,
-		MCPClusterKubeconfig:     string(kubeconfigs.MCPCluster),
-		CAConfigMap:              c.CaConfigMap,
+		Instance:             c.Instance,
+		Version:              c.Version,
+		Sources:              c.Sources,
+		WorkloadCluster:      c.WorkloadCluster,
+		Scheduling:           c.Scheduling,
+		VerticalScaling:      c.VerticalScaling,
+		PodSecurityContext:   c.PodSecurityContext,
+		SecurityContext:      c.SecurityContext,
+		Image:                c.ManifestDeployer.Image,
+		Resources:            c.ManifestDeployer.Resources,
+		HPA:                  c.ManifestDeployer.HPA,
+		MCPClusterKubeconfig: kubeconfigs.Deployer(api.DeployerManifest),
+		CAConfigMap:          c.CaConfigMap,
+	}
+
+	if dc := c.ManifestDeployer.Configuration; dc != nil {
+		v.VerbosityLevel = deployerVerbosity(dc)
+		v.Configuration.TargetSelector = targetSelectors(dc.TargetSelectors)
+		v.Configuration.Export.DefaultTimeout = exportTimeout(dc)
+		if dc.Workers != nil {
+			v.Configuration.Controller.Workers = int(*dc.Workers)
+		}
+		if dc.MaxReplicas != nil {
+			v.HPA.MaxReplicas = *dc.MaxReplicas
+			v.Configuration.HPAConfiguration = &manifestv1alpha2.HPAConfiguration{MaxReplicas: *dc.MaxReplicas}
+		}
 	}
 
 	return v
@@ -42,33 +75,135 @@ func manifestDeployerValues(c *Configuration, kubeconfigs *rbac.Kubeconfigs) *ma
 // helmDeployerValues determines the import values for the installation of the helm deployer
 func helmDeployerValues(c *Configuration, kubeconfigs *rbac.Kubeconfigs) *helmdeployer.Values {
 	v := &helmdeployer.Values{
-		Instance:                 c.Instance,
-		Version:                  c.Version,
-		PlatformCluster:          c.PlatformCluster,
-		PlatformClusterNamespace: c.PlatformClusterNamespace,
-		WorkloadCluster:          c.WorkloadCluster,
-		Image:                    c.HelmDeployer.Image,
-		Resources:                c.HelmDeployer.Resources,
-		HPA:                      c.HelmDeployer.HPA,
-		MCPClusterKubeconfig:     string(kubeconfigs.MCPCluster),
-		CAConfigMap:              c.CaConfigMap,
+		Instance:             c.Instance,
+		Version:              c.Version,
+		Sources:              c.Sources,
+		WorkloadCluster:      c.WorkloadCluster,
+		Scheduling:           c.Scheduling,
+		VerticalScaling:      c.VerticalScaling,
+		PodSecurityContext:   c.PodSecurityContext,
+		SecurityContext:      c.SecurityContext,
+		Image:                c.HelmDeployer.Image,
+		Resources:            c.HelmDeployer.Resources,
+		HPA:                  c.HelmDeployer.HPA,
+		MCPClusterKubeconfig: kubeconfigs.Deployer(api.DeployerHelm),
+		CAConfigMap:          c.CaConfigMap,
+	}
+
+	if dc := c.HelmDeployer.Configuration; dc != nil {
+		v.VerbosityLevel = deployerVerbosity(dc)
+		v.Configuration.TargetSelector = targetSelectors(dc.TargetSelectors)
+		v.Configuration.Export.DefaultTimeout = exportTimeout(dc)
+		if dc.Workers != nil {
+			v.Configuration.Controller.Workers = int(*dc.Workers)
+		}
+		if dc.MaxReplicas != nil {
+			v.HPA.MaxReplicas = *dc.MaxReplicas
+			v.Configuration.HPAConfiguration = &helmv1alpha1.HPAConfiguration{MaxReplicas: *dc.MaxReplicas}
+		}
+	}
+
+	if c.HelmDeployer.OCI != nil {
+		v.OCI = &helmdeployer.OCIValues{
+			AllowPlainHttp:     c.HelmDeployer.OCI.AllowPlainHTTP,
+			InsecureSkipVerify: c.HelmDeployer.OCI.InsecureSkipVerify,
+			Secrets:            map[string]any{},
+		}
+		for name, content := range c.HelmDeployer.OCI.ConfigFiles {
+			v.OCI.Secrets[name] = json.RawMessage(content)
+		}
 	}
 
 	return v
 }
 
+// containerDeployerValues determines the import values for the installation of the container deployer
+func containerDeployerValues(c *Configuration, d *DeployerConfig, kubeconfigs *rbac.Kubeconfigs) *containerdeployer.Values {
+	return &containerdeployer.Values{
+		Instance:                  c.Instance,
+		Version:                   c.Version,
+		Sources:                   c.Sources,
+		WorkloadCluster:           c.WorkloadCluster,
+		Scheduling:                c.Scheduling,
+		VerticalScaling:           c.VerticalScaling,
+		PodSecurityContext:        c.PodSecurityContext,
+		SecurityContext:           c.SecurityContext,
+		Image:                     d.Image,
+		InitImage:                 d.InitImage,
+		WaitImage:                 d.WaitImage,
+		Resources:                 d.Resources,
+		HPA:                       d.HPA,
+		MCPClusterKubeconfig:      kubeconfigs.Deployer(d.Name),
+		WorkloadClusterKubeconfig: string(kubeconfigs.WorkloadCluster),
+		CAConfigMap:               c.CaConfigMap,
+	}
+}
+
+// customDeployerValues determines the import values for the installation of a custom deployer.
+// The mock deployer is installed as a custom deployer, with its default configuration unless the offering has one.
+func customDeployerValues(c *Configuration, d *DeployerConfig, kubeconfigs *rbac.Kubeconfigs) (*customdeployer.Values, error) {
+	configuration := d.Configuration
+	if d.Name == api.DeployerMock && configuration == nil {
+		var err error
+		configuration, err = mockDeployerConfiguration(c.Instance)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &customdeployer.Values{
+		Instance:             c.Instance,
+		Version:              c.Version,
+		Name:                 d.Name,
+		Sources:              c.Sources,
+		WorkloadCluster:      c.WorkloadCluster,
+		Scheduling:           c.Scheduling,
+		VerticalScaling:      c.VerticalScaling,
+		PodSecurityContext:   c.PodSecurityContext,
+		SecurityContext:      c.SecurityContext,
+		Image:                d.Image,
+		Resources:            d.Resources,
+		HPA:                  d.HPA,
+		Configuration:        configuration,
+		MCPClusterKubeconfig: kubeconfigs.Deployer(d.Name),
+		CAConfigMap:          c.CaConfigMap,
+	}, nil
+}
+
+// mockDeployerConfiguration returns the default configuration file of the mock deployer.
+func mockDeployerConfiguration(instance identity.Instance) (*runtime.RawExtension, error) {
+	configuration := mockv1alpha1.Configuration{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "mock.deployer.landscaper.gardener.cloud/v1alpha1",
+			Kind:       "Configuration",
+		},
+		Identity: fmt.Sprintf("mock-deployer-%s", instance),
+	}
+	configuration.Controller.Workers = 5
+
+	raw, err := json.Marshal(configuration)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal mock deployer config: %w", err)
+	}
+	return &runtime.RawExtension{Raw: raw}, nil
+}
+
 // landscaperValues determines the import values for the installation of the landscaper controllers and webhooks server
-func landscaperValues(c *Configuration, kubeconfigs *rbac.Kubeconfigs, manifestExports *manifestdeployer.Exports, helmExports *helmdeployer.Exports) *landscaper.Values {
+func landscaperValues(c *Configuration, kubeconfigs *rbac.Kubeconfigs, manifestExports *manifestdeployer.Exports, helmExports *helmdeployer.Exports, deployerDeployments []string) *landscaper.Values {
 	v := &landscaper.Values{
-		Instance:                 c.Instance,
-		Version:                  c.Version,
-		PlatformCluster:          c.PlatformCluster,
-		PlatformClusterNamespace: c.PlatformClusterNamespace,
-		WorkloadCluster:          c.WorkloadCluster,
-		VerbosityLevel:           "INFO",
-		Configuration:            v1alpha1.LandscaperConfiguration{},
+		Instance:           c.Instance,
+		Version:            c.Version,
+		Sources:            c.Sources,
+		WorkloadCluster:    c.WorkloadCluster,
+		Scheduling:         c.Scheduling,
+		VerticalScaling:    c.VerticalScaling,
+		PodSecurityContext: c.PodSecurityContext,
+		SecurityContext:    c.SecurityContext,
+		NetworkPolicies:    c.NetworkPolicies,
+		VerbosityLevel:     "INFO",
+		Configuration:      v1alpha1.LandscaperConfiguration{},
 		Controller: landscaper.ControllerValues{
-			MCPKubeconfig:      string(kubeconfigs.MCPCluster),
+			MCPKubeconfig:      string(kubeconfigs.LandscaperController),
 			WorkloadKubeconfig: string(kubeconfigs.WorkloadCluster),
 			Image:              c.Landscaper.Controller.Image,
 			ReplicaCount:       ptr.To[int32](1),
@@ -79,8 +214,8 @@ func landscaperValues(c *Configuration, kubeconfigs *rbac.Kubeconfigs, manifestE
 			CAConfigMap:        c.CaConfigMap,
 		},
 		WebhooksServer: landscaper.WebhooksServerValues{
-			DisableWebhooks: nil,
-			MCPKubeconfig:   string(kubeconfigs.MCPCluster),
+			DisableWebhooks: c.Landscaper.WebhooksServer.DisableWebhooks,
+			MCPKubeconfig:   string(kubeconfigs.WebhooksServer),
 			Image:           c.Landscaper.WebhooksServer.Image,
 			ServicePort:     c.Landscaper.WebhooksServer.ServicePort,
 			Service: &landscaper.ServiceValues{
@@ -94,6 +229,31 @@ func landscaperValues(c *Configuration, kubeconfigs *rbac.Kubeconfigs, manifestE
 		},
 	}
 
+	if cc := c.Landscaper.Controller.Configuration; cc != nil {
+		setControllerConfiguration(v, cc)
+	}
+
+	if c.Landscaper.OCI != nil {
+		v.OCI = &landscaper.OCIValues{
+			AllowPlainHttp:     c.Landscaper.OCI.AllowPlainHTTP,
+			InsecureSkipVerify: c.Landscaper.OCI.InsecureSkipVerify,
+			Secrets:            c.Landscaper.OCI.ConfigFiles,
+		}
+	}
+	if c.Landscaper.OCICache != nil {
+		v.OCICache = &landscaper.OCICacheValues{
+			UseInMemoryOverlay: c.Landscaper.OCICache.UseInMemoryOverlay,
+			SizeLimit:          c.Landscaper.OCICache.SizeLimit,
+			Medium:             c.Landscaper.OCICache.Medium,
+		}
+		if persistent := c.Landscaper.OCICache.Persistent; persistent != nil {
+			v.OCICache.Persistent = &landscaper.PersistentCacheValues{
+				StorageClassName: persistent.StorageClassName,
+				Size:             persistent.Size,
+			}
+		}
+	}
+
 	// Deployments to be considered by the health checks
 	deployments := []string{}
 	if manifestExports != nil {
@@ -102,9 +262,93 @@ func landscaperValues(c *Configuration, kubeconfigs *rbac.Kubeconfigs, manifestE
 	if helmExports != nil {
 		deployments = append(deployments, helmExports.DeploymentName)
 	}
+	deployments = append(deployments, deployerDeployments...)
 	v.Controller.HealthChecks = &v1alpha1.AdditionalDeployments{
 		Deployments: deployments,
 	}
 
 	return v
 }
+
+// setControllerConfiguration applies the configuration of the controllers set in the Landscaper resource.
+// Unset values keep their defaults.
+func setControllerConfiguration(v *landscaper.Values, cc *api.ControllerConfiguration) {
+	if cc.Verbosity != "" {
+		v.VerbosityLevel = string(cc.Verbosity)
+	}
+
+	v.Controller.Contexts.Config.Default.ExcludedNamespaces = cc.ExcludedNamespaces
+
+	if cc.DeployItemTimeouts != nil {
+		v.Controller.DeployItemTimeouts = &v1alpha1.DeployItemTimeouts{}
+		if cc.DeployItemTimeouts.Pickup != nil {
+			v.Controller.DeployItemTimeouts.Pickup = &lscore.Duration{Duration: cc.DeployItemTimeouts.Pickup.Duration}
+		}
+		if cc.DeployItemTimeouts.Abort != nil {
+			v.Controller.DeployItemTimeouts.Abort = &lscore.Duration{Duration: cc.DeployItemTimeouts.Abort.Duration}
+		}
+	}
+
+	if w := cc.Workers; w != nil {
+		if w.Installations != nil {
+			v.Controller.Installations.Workers = int(*w.Installations)
+		}
+		if w.Executions != nil {
+			v.Controller.Executions.Workers = int(*w.Executions)
+		}
+		if w.DeployItems != nil {
+			v.Controller.DeployItems.Workers = int(*w.DeployItems)
+		}
+		if w.Contexts != nil {
+			v.Controller.Contexts.Workers = int(*w.Contexts)
+		}
+	}
+}
+
+// deployerVerbosity returns the log level of the helm or manifest deployer, or an empty string if the default applies.
+// The deployers expect the log level in lower case.
+func deployerVerbosity(dc *api.DeployerConfiguration) string {
+	return strings.ToLower(string(dc.Verbosity))
+}
+
+// exportTimeout returns the default timeout for exports of the helm or manifest deployer, or nil if the default applies.
+func exportTimeout(dc *api.DeployerConfiguration) *lscore.Duration {
+	if dc.DefaultExportTimeout == nil {
+		return nil
+	}
+	return &lscore.Duration{Duration: dc.DefaultExportTimeout.Duration}
+}
+
+// targetSelectors converts the target selectors of a Landscaper resource into those of the deployer configuration.
+func targetSelectors(selectors []api.TargetSelector) []lscore.TargetSelector {
+	if len(selectors) == 0 {
+		return nil
+	}
+	res := make([]lscore.TargetSelector, 0, len(selectors))
+	for _, s := range selectors {
+		ts := lscore.TargetSelector{
+			Annotations: requirements(s.Annotations),
+			Labels:      requirements(s.Labels),
+		}
+		for _, t := range s.Targets {
+			ts.Targets = append(ts.Targets, lscore.ObjectReference{Name: t.Name, Namespace: t.Namespace})
+		}
+		res = append(res, ts)
+	}
+	return res
+}
+
+func requirements(reqs []api.SelectorRequirement) []lscore.Requirement {
+	if len(reqs) == 0 {
+		return nil
+	}
+	res := make([]lscore.Requirement, 0, len(reqs))
+	for _, r := range reqs {
+		res = append(res, lscore.Requirement{
+			Key:      r.Key,
+			Operator: selection.Operator(r.Operator),
+			Values:   r.Values,
+		})
+	}
+	return res
+}
@@ -0,0 +1,21 @@
+package instance
+
+import (
+	"context"
+
+	api "github.com/openmcp-project/service-provider-landscaper/api/v1alpha2"
+	"github.com/openmcp-project/service-provider-landscaper/internal/shared/verticalscaling"
+)
+
+// ResourceRecommendations returns the resource requests which the vertical pod autoscalers recommend
+// for the components of the instance. It returns an empty list if no vertical pod autoscalers are configured.
+func ResourceRecommendations(ctx context.Context, config *Configuration) ([]api.ResourceRecommendation, error) {
+	if config.VerticalScaling == nil {
+		return []api.ResourceRecommendation{}, nil
+	}
+	vpa := &verticalscaling.VPA{
+		WorkloadCluster:          config.WorkloadCluster,
+		WorkloadClusterNamespace: config.Instance.Namespace(),
+	}
+	return vpa.Recommendations(ctx)
+}
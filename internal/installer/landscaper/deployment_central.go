@@ -1,8 +1,6 @@
 package landscaper
 
 import (
-	"crypto/sha256"
-	"encoding/hex"
 	"fmt"
 	"strconv"
 
@@ -15,6 +13,8 @@ import (
 	"github.com/openmcp-project/controller-utils/pkg/resources"
 
 	configmapsync "github.com/openmcp-project/service-provider-landscaper/internal/shared/configmaps"
+	"github.com/openmcp-project/service-provider-landscaper/internal/shared/kubeconfigs"
+	"github.com/openmcp-project/service-provider-landscaper/internal/shared/types"
 )
 
 type centralDeploymentMutator struct {
@@ -75,20 +75,15 @@ func (m *centralDeploymentMutator) Mutate(r *appsv1.Deployment) error {
 			},
 		},
 	}
+	m.values.Scheduling.ApplyTo(&r.Spec.Template.Spec, m.controllerComponent.TopologyLabels())
 	return nil
 }
 
 func (m *centralDeploymentMutator) templateAnnotations() map[string]string {
-	hash := sha256.Sum256([]byte(m.values.Controller.MCPKubeconfig))
-	mcpKubeconfigHash := hex.EncodeToString(hash[:])
-
-	hash = sha256.Sum256([]byte(m.values.Controller.WorkloadKubeconfig))
-	workloadKubeconfigHash := hex.EncodeToString(hash[:])
-
 	annotations := map[string]string{
 		"checksum/config":             m.configHash,
-		"checksum/mcpKubeconfig":      mcpKubeconfigHash,
-		"checksum/workloadKubeconfig": workloadKubeconfigHash,
+		"checksum/mcpKubeconfig":      m.controllerMCPKubeconfig.Hash(),
+		"checksum/workloadKubeconfig": m.controllerWorkloadKubeconfig.Hash(),
 	}
 	return annotations
 }
@@ -110,10 +105,8 @@ func (m *centralDeploymentMutator) containers() []corev1.Container {
 func (m *centralDeploymentMutator) volumes() []corev1.Volume {
 	volumes := []corev1.Volume{
 		{
-			Name: "oci-cache",
-			VolumeSource: corev1.VolumeSource{
-				EmptyDir: &corev1.EmptyDirVolumeSource{},
-			},
+			Name:         "oci-cache",
+			VolumeSource: m.ociCacheVolumeSource(),
 		},
 		{
 			Name: "config",
@@ -159,6 +152,8 @@ func (m *centralDeploymentMutator) volumes() []corev1.Volume {
 		volumes = append(volumes, caVolume)
 	}
 
+	volumes = append(volumes, types.TmpVolume())
+
 	return volumes
 }
 
@@ -166,7 +161,7 @@ func (m *centralDeploymentMutator) volumeMounts() []corev1.VolumeMount {
 	volumeMounts := []corev1.VolumeMount{
 		{
 			Name:      "oci-cache",
-			MountPath: "/app/ls/oci-cache",
+			MountPath: ociCachePath,
 		},
 		{
 			Name:      "config",
@@ -174,11 +169,11 @@ func (m *centralDeploymentMutator) volumeMounts() []corev1.VolumeMount {
 		},
 		{
 			Name:      m.controllerMCPKubeconfigSecretName(),
-			MountPath: fmt.Sprint("/app/ls/", m.controllerMCPKubeconfigSecretName()),
+			MountPath: kubeconfigs.MountPath(m.controllerMCPKubeconfigSecretName()),
 		},
 		{
 			Name:      m.controllerWorkloadKubeconfigSecretName(),
-			MountPath: fmt.Sprint("/app/ls/", m.controllerWorkloadKubeconfigSecretName()),
+			MountPath: kubeconfigs.MountPath(m.controllerWorkloadKubeconfigSecretName()),
 		},
 	}
 
@@ -190,13 +185,15 @@ func (m *centralDeploymentMutator) volumeMounts() []corev1.VolumeMount {
 		})
 	}
 
+	volumeMounts = append(volumeMounts, types.TmpVolumeMount())
+
 	return volumeMounts
 }
 
 func (m *centralDeploymentMutator) args() []string {
 	a := []string{
 		"--config=/app/ls/config/config.yaml",
-		fmt.Sprint("--landscaper-kubeconfig=/app/ls/", m.controllerMCPKubeconfigSecretName(), "/kubeconfig"),
+		"--landscaper-kubeconfig=" + kubeconfigs.KubeconfigPath(m.controllerMCPKubeconfigSecretName()),
 	}
 	if m.values.VerbosityLevel != "" {
 		a = append(a, fmt.Sprintf("-v=%s", m.values.VerbosityLevel))
@@ -209,7 +206,7 @@ func (m *centralDeploymentMutator) env() []corev1.EnvVar {
 	envVars := []corev1.EnvVar{
 		{
 			Name:  "KUBECONFIG",
-			Value: fmt.Sprint("/app/ls/", m.controllerWorkloadKubeconfigSecretName(), "/kubeconfig"),
+			Value: kubeconfigs.KubeconfigPath(m.controllerWorkloadKubeconfigSecretName()),
 		},
 		{
 			Name:  "LANDSCAPER_MODE",
@@ -1,8 +1,6 @@
 package landscaper
 
 import (
-	"crypto/sha256"
-	"encoding/hex"
 	"fmt"
 	"strconv"
 
@@ -15,6 +13,8 @@ import (
 	"github.com/openmcp-project/controller-utils/pkg/resources"
 
 	configmapsync "github.com/openmcp-project/service-provider-landscaper/internal/shared/configmaps"
+	"github.com/openmcp-project/service-provider-landscaper/internal/shared/kubeconfigs"
+	"github.com/openmcp-project/service-provider-landscaper/internal/shared/types"
 )
 
 type mainDeploymentMutator struct {
@@ -76,11 +76,13 @@ func (m *mainDeploymentMutator) Mutate(r *appsv1.Deployment) error {
 			},
 		},
 	}
+	m.values.Scheduling.ApplyTo(&r.Spec.Template.Spec, m.controllerMainComponent.TopologyLabels())
 	return nil
 }
 
 func (m *mainDeploymentMutator) strategy() appsv1.DeploymentStrategy {
 	strategy := appsv1.DeploymentStrategy{}
+	// a single pod, e.g. with a persistent cache volume which can only be attached to one node, is stopped first
 	if m.values.Controller.HPAMain.MaxReplicas == 1 {
 		strategy.Type = appsv1.RecreateDeploymentStrategyType
 	}
@@ -88,16 +90,11 @@ func (m *mainDeploymentMutator) strategy() appsv1.DeploymentStrategy {
 }
 
 func (m *mainDeploymentMutator) templateAnnotations() map[string]string {
-	hash := sha256.Sum256([]byte(m.values.Controller.MCPKubeconfig))
-	mcpKubeconfigHash := hex.EncodeToString(hash[:])
-
-	hash = sha256.Sum256([]byte(m.values.Controller.WorkloadKubeconfig))
-	workloadKubeconfigHash := hex.EncodeToString(hash[:])
-
 	annotations := map[string]string{
 		"checksum/config":             m.configHash,
-		"checksum/mcpKubeconfig":      mcpKubeconfigHash,
-		"checksum/workloadKubeconfig": workloadKubeconfigHash,
+		"checksum/registrysecrets":    m.registrySecretsHash,
+		"checksum/mcpKubeconfig":      m.controllerMCPKubeconfig.Hash(),
+		"checksum/workloadKubeconfig": m.controllerWorkloadKubeconfig.Hash(),
 	}
 	return annotations
 }
@@ -119,10 +116,8 @@ func (m *mainDeploymentMutator) containers() []corev1.Container {
 func (m *mainDeploymentMutator) volumes() []corev1.Volume {
 	volumes := []corev1.Volume{
 		{
-			Name: "oci-cache",
-			VolumeSource: corev1.VolumeSource{
-				EmptyDir: &corev1.EmptyDirVolumeSource{},
-			},
+			Name:         "oci-cache",
+			VolumeSource: m.ociCacheVolume(),
 		},
 		{
 			Name: "config",
@@ -150,6 +145,18 @@ func (m *mainDeploymentMutator) volumes() []corev1.Volume {
 		},
 	}
 
+	if m.values.OCI != nil {
+		volumes = append(volumes, corev1.Volume{
+			Name: "ociregistry",
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{
+					SecretName:  m.registriesSecretName(),
+					DefaultMode: ptr.To[int32](0440),
+				},
+			},
+		})
+	}
+
 	if m.values.Controller.CAConfigMap != nil {
 		caVolume := corev1.Volume{
 			Name: configmapsync.CustomCaVolumeName,
@@ -168,14 +175,27 @@ func (m *mainDeploymentMutator) volumes() []corev1.Volume {
 		volumes = append(volumes, caVolume)
 	}
 
+	volumes = append(volumes, types.TmpVolume())
+
 	return volumes
 }
 
+func (m *mainDeploymentMutator) ociCacheVolume() corev1.VolumeSource {
+	if m.hasPersistentOCICache() {
+		return corev1.VolumeSource{
+			PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+				ClaimName: m.ociCachePVCName(),
+			},
+		}
+	}
+	return m.ociCacheVolumeSource()
+}
+
 func (m *mainDeploymentMutator) volumeMounts() []corev1.VolumeMount {
 	volumeMounts := []corev1.VolumeMount{
 		{
 			Name:      "oci-cache",
-			MountPath: "/app/ls/oci-cache",
+			MountPath: ociCachePath,
 		},
 		{
 			Name:      "config",
@@ -183,14 +203,22 @@ func (m *mainDeploymentMutator) volumeMounts() []corev1.VolumeMount {
 		},
 		{
 			Name:      m.controllerMCPKubeconfigSecretName(),
-			MountPath: fmt.Sprint("/app/ls/", m.controllerMCPKubeconfigSecretName()),
+			MountPath: kubeconfigs.MountPath(m.controllerMCPKubeconfigSecretName()),
 		},
 		{
 			Name:      m.controllerWorkloadKubeconfigSecretName(),
-			MountPath: fmt.Sprint("/app/ls/", m.controllerWorkloadKubeconfigSecretName()),
+			MountPath: kubeconfigs.MountPath(m.controllerWorkloadKubeconfigSecretName()),
 		},
 	}
 
+	if m.values.OCI != nil {
+		volumeMounts = append(volumeMounts, corev1.VolumeMount{
+			Name:      "ociregistry",
+			MountPath: registrySecretsPath,
+			ReadOnly:  true,
+		})
+	}
+
 	if m.values.Controller.CAConfigMap != nil {
 		volumeMounts = append(volumeMounts, corev1.VolumeMount{
 			Name:      configmapsync.CustomCaVolumeName,
@@ -199,13 +227,15 @@ func (m *mainDeploymentMutator) volumeMounts() []corev1.VolumeMount {
 		})
 	}
 
+	volumeMounts = append(volumeMounts, types.TmpVolumeMount())
+
 	return volumeMounts
 }
 
 func (m *mainDeploymentMutator) args() []string {
 	a := []string{
 		"--config=/app/ls/config/config.yaml",
-		fmt.Sprint("--landscaper-kubeconfig=/app/ls/", m.controllerMCPKubeconfigSecretName(), "/kubeconfig"),
+		"--landscaper-kubeconfig=" + kubeconfigs.KubeconfigPath(m.controllerMCPKubeconfigSecretName()),
 	}
 	if m.values.VerbosityLevel != "" {
 		a = append(a, fmt.Sprintf("-v=%s", m.values.VerbosityLevel))
@@ -217,7 +247,7 @@ func (m *mainDeploymentMutator) env() []corev1.EnvVar {
 	envVars := []corev1.EnvVar{
 		{
 			Name:  "KUBECONFIG",
-			Value: fmt.Sprint("/app/ls/", m.controllerWorkloadKubeconfigSecretName(), "/kubeconfig"),
+			Value: kubeconfigs.KubeconfigPath(m.controllerWorkloadKubeconfigSecretName()),
 		},
 		{
 			Name: "MY_POD_NAME",
@@ -1,8 +1,6 @@
 package landscaper
 
 import (
-	"crypto/sha256"
-	"encoding/hex"
 	"fmt"
 	"strings"
 
@@ -14,6 +12,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	configmapsync "github.com/openmcp-project/service-provider-landscaper/internal/shared/configmaps"
+	"github.com/openmcp-project/service-provider-landscaper/internal/shared/kubeconfigs"
+	"github.com/openmcp-project/service-provider-landscaper/internal/shared/types"
 )
 
 type webhooksDeploymentMutator struct {
@@ -74,15 +74,13 @@ func (m *webhooksDeploymentMutator) Mutate(r *appsv1.Deployment) error {
 			},
 		},
 	}
+	m.values.Scheduling.ApplyTo(&r.Spec.Template.Spec, m.webhooksComponent.TopologyLabels())
 	return nil
 }
 
 func (m *webhooksDeploymentMutator) templateAnnotations() map[string]string {
-	hash := sha256.Sum256([]byte(m.values.Controller.MCPKubeconfig))
-	mcpKubeconfigHash := hex.EncodeToString(hash[:])
-
 	annotations := map[string]string{
-		"checksum/mcpKubeconfig": mcpKubeconfigHash,
+		"checksum/mcpKubeconfig": m.webhooksMCPKubeconfig.Hash(),
 	}
 	return annotations
 }
@@ -103,10 +101,10 @@ func (m *webhooksDeploymentMutator) containers() []corev1.Container {
 func (m *webhooksDeploymentMutator) volumes() []corev1.Volume {
 	volumes := []corev1.Volume{
 		{
-			Name: m.controllerMCPKubeconfigSecretName(),
+			Name: m.webhooksKubeconfigSecretName(),
 			VolumeSource: corev1.VolumeSource{
 				Secret: &corev1.SecretVolumeSource{
-					SecretName: m.controllerMCPKubeconfigSecretName(),
+					SecretName: m.webhooksKubeconfigSecretName(),
 				},
 			},
 		},
@@ -130,14 +128,16 @@ func (m *webhooksDeploymentMutator) volumes() []corev1.Volume {
 		volumes = append(volumes, caVolume)
 	}
 
+	volumes = append(volumes, types.TmpVolume())
+
 	return volumes
 }
 
 func (m *webhooksDeploymentMutator) volumeMounts() []corev1.VolumeMount {
 	volumeMounts := []corev1.VolumeMount{
 		{
-			Name:      m.controllerMCPKubeconfigSecretName(),
-			MountPath: fmt.Sprint("/app/ls/", m.controllerMCPKubeconfigSecretName()),
+			Name:      m.webhooksKubeconfigSecretName(),
+			MountPath: kubeconfigs.MountPath(m.webhooksKubeconfigSecretName()),
 		},
 	}
 
@@ -149,6 +149,8 @@ func (m *webhooksDeploymentMutator) volumeMounts() []corev1.VolumeMount {
 		})
 	}
 
+	volumeMounts = append(volumeMounts, types.TmpVolumeMount())
+
 	return volumeMounts
 }
 
@@ -180,7 +182,7 @@ func (m *webhooksDeploymentMutator) env() []corev1.EnvVar {
 	envVars := []corev1.EnvVar{
 		{
 			Name:  "KUBECONFIG",
-			Value: fmt.Sprint("/app/ls/", m.controllerMCPKubeconfigSecretName(), "/kubeconfig"),
+			Value: kubeconfigs.KubeconfigPath(m.webhooksKubeconfigSecretName()),
 		},
 	}
 
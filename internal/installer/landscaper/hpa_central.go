@@ -5,9 +5,10 @@ import (
 
 	v2 "k8s.io/api/autoscaling/v2"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/utils/ptr"
 
 	"github.com/openmcp-project/controller-utils/pkg/resources"
+
+	"github.com/openmcp-project/service-provider-landscaper/internal/shared/types"
 )
 
 type centralHPAMutator struct {
@@ -40,36 +41,9 @@ func (m *centralHPAMutator) MetadataMutator() resources.MetadataMutator {
 
 func (m *centralHPAMutator) Mutate(r *v2.HorizontalPodAutoscaler) error {
 	r.Labels = m.controllerComponent.Labels()
-	r.Spec = v2.HorizontalPodAutoscalerSpec{
-		ScaleTargetRef: v2.CrossVersionObjectReference{
-			APIVersion: "apps/v1",
-			Kind:       "Deployment",
-			Name:       m.landscaperFullName(),
-		},
-		MinReplicas: ptr.To[int32](1),
-		MaxReplicas: 1,
-		Metrics: []v2.MetricSpec{
-			{
-				Type: "Resource",
-				Resource: &v2.ResourceMetricSource{
-					Name: "cpu",
-					Target: v2.MetricTarget{
-						Type:               "Utilization",
-						AverageUtilization: ptr.To[int32](80),
-					},
-				},
-			},
-			{
-				Type: "Resource",
-				Resource: &v2.ResourceMetricSource{
-					Name: "memory",
-					Target: v2.MetricTarget{
-						Type:               "Utilization",
-						AverageUtilization: ptr.To[int32](80),
-					},
-				},
-			},
-		},
-	}
+	// The central controller is not scaled, its autoscaler has fix values.
+	hpa := &types.HPAValues{}
+	hpa.Default(1, 1)
+	r.Spec = hpa.Spec(m.landscaperFullName())
 	return nil
 }
@@ -5,7 +5,6 @@ import (
 
 	v2 "k8s.io/api/autoscaling/v2"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/utils/ptr"
 
 	"github.com/openmcp-project/controller-utils/pkg/resources"
 )
@@ -40,36 +39,6 @@ func (m *mainHPAMutator) MetadataMutator() resources.MetadataMutator {
 
 func (m *mainHPAMutator) Mutate(r *v2.HorizontalPodAutoscaler) error {
 	r.Labels = m.controllerMainComponent.Labels()
-	r.Spec = v2.HorizontalPodAutoscalerSpec{
-		ScaleTargetRef: v2.CrossVersionObjectReference{
-			APIVersion: "apps/v1",
-			Kind:       "Deployment",
-			Name:       m.landscaperMainFullName(),
-		},
-		MinReplicas: ptr.To[int32](1),
-		MaxReplicas: m.values.Controller.HPAMain.MaxReplicas,
-		Metrics: []v2.MetricSpec{
-			{
-				Type: "Resource",
-				Resource: &v2.ResourceMetricSource{
-					Name: "cpu",
-					Target: v2.MetricTarget{
-						Type:               "Utilization",
-						AverageUtilization: m.values.Controller.HPAMain.AverageCpuUtilization,
-					},
-				},
-			},
-			{
-				Type: "Resource",
-				Resource: &v2.ResourceMetricSource{
-					Name: "memory",
-					Target: v2.MetricTarget{
-						Type:               "Utilization",
-						AverageUtilization: m.values.Controller.HPAMain.AverageMemoryUtilization,
-					},
-				},
-			},
-		},
-	}
+	r.Spec = m.values.Controller.HPAMain.Spec(m.landscaperMainFullName())
 	return nil
 }
@@ -5,7 +5,6 @@ import (
 
 	v2 "k8s.io/api/autoscaling/v2"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/utils/ptr"
 
 	"github.com/openmcp-project/controller-utils/pkg/resources"
 )
@@ -40,36 +39,6 @@ func (m *webhooksHPAMutator) MetadataMutator() resources.MetadataMutator {
 
 func (m *webhooksHPAMutator) Mutate(r *v2.HorizontalPodAutoscaler) error {
 	r.Labels = m.webhooksComponent.Labels()
-	r.Spec = v2.HorizontalPodAutoscalerSpec{
-		ScaleTargetRef: v2.CrossVersionObjectReference{
-			APIVersion: "apps/v1",
-			Kind:       "Deployment",
-			Name:       m.landscaperWebhooksFullName(),
-		},
-		MinReplicas: ptr.To[int32](2),
-		MaxReplicas: m.values.WebhooksServer.HPA.MaxReplicas,
-		Metrics: []v2.MetricSpec{
-			{
-				Type: "Resource",
-				Resource: &v2.ResourceMetricSource{
-					Name: "cpu",
-					Target: v2.MetricTarget{
-						Type:               "Utilization",
-						AverageUtilization: m.values.WebhooksServer.HPA.AverageCpuUtilization,
-					},
-				},
-			},
-			{
-				Type: "Resource",
-				Resource: &v2.ResourceMetricSource{
-					Name: "memory",
-					Target: v2.MetricTarget{
-						Type:               "Utilization",
-						AverageUtilization: m.values.WebhooksServer.HPA.AverageMemoryUtilization,
-					},
-				},
-			},
-		},
-	}
+	r.Spec = m.values.WebhooksServer.HPA.Spec(m.landscaperWebhooksFullName())
 	return nil
 }
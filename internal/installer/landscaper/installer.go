@@ -4,8 +4,10 @@ import (
 	"context"
 
 	imgpullsecrets "github.com/openmcp-project/service-provider-landscaper/internal/shared/imagepullsecrets"
+	"github.com/openmcp-project/service-provider-landscaper/internal/shared/verticalscaling"
 
 	appsv1 "k8s.io/api/apps/v1"
+	core "k8s.io/api/core/v1"
 
 	"github.com/openmcp-project/controller-utils/pkg/readiness"
 	"github.com/openmcp-project/controller-utils/pkg/resources"
@@ -20,13 +22,12 @@ func InstallLandscaper(ctx context.Context, values *Values) error {
 
 	workloadClient := values.WorkloadCluster.Client()
 
-	if err := resources.CreateOrUpdateResource(ctx, workloadClient, resources.NewNamespaceMutator(valHelper.workloadNamespace())); err != nil {
+	if err := resources.CreateOrUpdateResource(ctx, workloadClient, values.Instance.NamespaceMutator()); err != nil {
 		return err
 	}
 
 	imgPullSecretsSync := imgpullsecrets.SecretSync{
-		PlatformCluster:          values.PlatformCluster,
-		PlatformClusterNamespace: values.PlatformClusterNamespace,
+		Sources:                  values.Sources,
 		WorkloadCluster:          values.WorkloadCluster,
 		WorkloadClusterNamespace: valHelper.workloadNamespace(),
 	}
@@ -41,9 +42,12 @@ func InstallLandscaper(ctx context.Context, values *Values) error {
 		return err
 	}
 
-	webhooksImagePullSecrets, err := imgPullSecretsSync.CreateOrUpdate(ctx, valHelper.webhooksComponent, values.WebhooksServer.Image.ImagePullSecrets)
-	if err != nil {
-		return err
+	var webhooksImagePullSecrets []core.LocalObjectReference
+	if !valHelper.areAllWebhooksDisabled() {
+		webhooksImagePullSecrets, err = imgPullSecretsSync.CreateOrUpdate(ctx, valHelper.webhooksComponent, values.WebhooksServer.Image.ImagePullSecrets)
+		if err != nil {
+			return err
+		}
 	}
 
 	if err := resources.CreateOrUpdateResource(ctx, workloadClient, newControllerMCPKubeconfigSecretMutator(valHelper)); err != nil {
@@ -54,14 +58,28 @@ func InstallLandscaper(ctx context.Context, values *Values) error {
 		return err
 	}
 
-	if err := resources.CreateOrUpdateResource(ctx, workloadClient, newWebhooksKubeconfigSecretMutator(valHelper)); err != nil {
-		return err
+	if !valHelper.areAllWebhooksDisabled() {
+		if err := resources.CreateOrUpdateResource(ctx, workloadClient, newWebhooksKubeconfigSecretMutator(valHelper)); err != nil {
+			return err
+		}
 	}
 
 	if err := resources.CreateOrUpdateResource(ctx, workloadClient, newConfigSecretMutator(valHelper)); err != nil {
 		return err
 	}
 
+	if values.OCI != nil {
+		if err := resources.CreateOrUpdateResource(ctx, workloadClient, newRegistriesSecretMutator(valHelper)); err != nil {
+			return err
+		}
+	}
+
+	if valHelper.hasPersistentOCICache() {
+		if err := resources.CreateOrUpdateResource(ctx, workloadClient, newOCICachePVCMutator(valHelper)); err != nil {
+			return err
+		}
+	}
+
 	if err := resources.CreateOrUpdateResource(ctx, workloadClient, newServiceMutator(valHelper)); err != nil {
 		return err
 	}
@@ -70,6 +88,13 @@ func InstallLandscaper(ctx context.Context, values *Values) error {
 		if err := resources.CreateOrUpdateResource(ctx, workloadClient, newWebhooksServiceMutator(valHelper)); err != nil {
 			return err
 		}
+		if values.NetworkPolicies != nil {
+			if err := resources.CreateOrUpdateResource(ctx, workloadClient, newWebhooksNetworkPolicyMutator(valHelper)); err != nil {
+				return err
+			}
+		} else if err := resources.DeleteResource(ctx, workloadClient, newWebhooksNetworkPolicyMutator(valHelper)); err != nil {
+			return err
+		}
 	}
 
 	if err := resources.CreateOrUpdateResource(ctx, workloadClient, newCentralDeploymentMutator(valHelper).
@@ -82,6 +107,20 @@ func InstallLandscaper(ctx context.Context, values *Values) error {
 		return err
 	}
 
+	// the registries secret is deleted after the main deployment no longer mounts it
+	if values.OCI == nil {
+		if err := resources.DeleteResource(ctx, workloadClient, newRegistriesSecretMutator(valHelper)); err != nil {
+			return err
+		}
+	}
+
+	// the persistent cache is deleted after the main deployment no longer mounts it
+	if !valHelper.hasPersistentOCICache() {
+		if err := resources.DeleteResource(ctx, workloadClient, newOCICachePVCMutator(valHelper)); err != nil {
+			return err
+		}
+	}
+
 	if !valHelper.areAllWebhooksDisabled() {
 		if err := resources.CreateOrUpdateResource(ctx, workloadClient, newWebhooksDeploymentMutator(valHelper).
 			WithImagePullSecrets(webhooksImagePullSecrets).Convert()); err != nil {
@@ -93,11 +132,77 @@ func InstallLandscaper(ctx context.Context, values *Values) error {
 		return err
 	}
 
+	if err := vpa(valHelper).Apply(ctx, valHelper.controllerMainComponent, verticalscaling.ForAutoscaler(values.VerticalScaling, &values.Controller.HPAMain)); err != nil {
+		return err
+	}
+
+	if values.Controller.HPAMain.MaxReplicas > 1 {
+		if err := resources.CreateOrUpdateResource(ctx, workloadClient, newMainPDBMutator(valHelper)); err != nil {
+			return err
+		}
+	} else if err := resources.DeleteResource(ctx, workloadClient, newMainPDBMutator(valHelper)); err != nil {
+		return err
+	}
+
 	if err := resources.CreateOrUpdateResource(ctx, workloadClient, newCentralHPAMutator(valHelper)); err != nil {
 		return err
 	}
 
-	if err := resources.CreateOrUpdateResource(ctx, workloadClient, newWebhooksHPAMutator(valHelper)); err != nil {
+	if err := vpa(valHelper).Apply(ctx, valHelper.controllerComponent, values.VerticalScaling); err != nil {
+		return err
+	}
+
+	if !valHelper.areAllWebhooksDisabled() {
+		if err := resources.CreateOrUpdateResource(ctx, workloadClient, newWebhooksHPAMutator(valHelper)); err != nil {
+			return err
+		}
+		if err := vpa(valHelper).Apply(ctx, valHelper.webhooksComponent, verticalscaling.ForAutoscaler(values.VerticalScaling, &values.WebhooksServer.HPA)); err != nil {
+			return err
+		}
+		if values.WebhooksServer.HPA.MaxReplicas > 1 {
+			if err := resources.CreateOrUpdateResource(ctx, workloadClient, newWebhooksPDBMutator(valHelper)); err != nil {
+				return err
+			}
+		} else if err := resources.DeleteResource(ctx, workloadClient, newWebhooksPDBMutator(valHelper)); err != nil {
+			return err
+		}
+	} else if err := uninstallWebhooksServer(ctx, valHelper); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// uninstallWebhooksServer deletes the resources of the webhooks server, which remain if all webhooks have been disabled
+// after the installation. The image pull secrets are kept, because they are shared with the other components.
+func uninstallWebhooksServer(ctx context.Context, valHelper *valuesHelper) error {
+	workloadClient := valHelper.values.WorkloadCluster.Client()
+
+	if err := resources.DeleteResource(ctx, workloadClient, newWebhooksPDBMutator(valHelper)); err != nil {
+		return err
+	}
+
+	if err := vpa(valHelper).Delete(ctx, valHelper.webhooksComponent); err != nil {
+		return err
+	}
+
+	if err := resources.DeleteResource(ctx, workloadClient, newWebhooksHPAMutator(valHelper)); err != nil {
+		return err
+	}
+
+	if err := resources.DeleteResource(ctx, workloadClient, newWebhooksDeploymentMutator(valHelper).Convert()); err != nil {
+		return err
+	}
+
+	if err := resources.DeleteResource(ctx, workloadClient, newWebhooksNetworkPolicyMutator(valHelper)); err != nil {
+		return err
+	}
+
+	if err := resources.DeleteResource(ctx, workloadClient, newWebhooksServiceMutator(valHelper)); err != nil {
+		return err
+	}
+
+	if err := resources.DeleteResource(ctx, workloadClient, newWebhooksKubeconfigSecretMutator(valHelper)); err != nil {
 		return err
 	}
 
@@ -113,14 +218,34 @@ func UninstallLandscaper(ctx context.Context, values *Values) error {
 
 	workloadClient := values.WorkloadCluster.Client()
 
+	if err := resources.DeleteResource(ctx, workloadClient, newWebhooksPDBMutator(valHelper)); err != nil {
+		return err
+	}
+
+	if err := resources.DeleteResource(ctx, workloadClient, newMainPDBMutator(valHelper)); err != nil {
+		return err
+	}
+
+	if err := vpa(valHelper).Delete(ctx, valHelper.webhooksComponent); err != nil {
+		return err
+	}
+
 	if err := resources.DeleteResource(ctx, workloadClient, newWebhooksHPAMutator(valHelper)); err != nil {
 		return err
 	}
 
+	if err := vpa(valHelper).Delete(ctx, valHelper.controllerComponent); err != nil {
+		return err
+	}
+
 	if err := resources.DeleteResource(ctx, workloadClient, newCentralHPAMutator(valHelper)); err != nil {
 		return err
 	}
 
+	if err := vpa(valHelper).Delete(ctx, valHelper.controllerMainComponent); err != nil {
+		return err
+	}
+
 	if err := resources.DeleteResource(ctx, workloadClient, newMainHPAMutator(valHelper)); err != nil {
 		return err
 	}
@@ -137,6 +262,10 @@ func UninstallLandscaper(ctx context.Context, values *Values) error {
 		return err
 	}
 
+	if err := resources.DeleteResource(ctx, workloadClient, newWebhooksNetworkPolicyMutator(valHelper)); err != nil {
+		return err
+	}
+
 	if err := resources.DeleteResource(ctx, workloadClient, newWebhooksServiceMutator(valHelper)); err != nil {
 		return err
 	}
@@ -149,6 +278,14 @@ func UninstallLandscaper(ctx context.Context, values *Values) error {
 		return err
 	}
 
+	if err := resources.DeleteResource(ctx, workloadClient, newRegistriesSecretMutator(valHelper)); err != nil {
+		return err
+	}
+
+	if err := resources.DeleteResource(ctx, workloadClient, newOCICachePVCMutator(valHelper)); err != nil {
+		return err
+	}
+
 	if err := resources.DeleteResource(ctx, workloadClient, newWebhooksKubeconfigSecretMutator(valHelper)); err != nil {
 		return err
 	}
@@ -161,8 +298,7 @@ func UninstallLandscaper(ctx context.Context, values *Values) error {
 	}
 
 	impPullSecretsSync := imgpullsecrets.SecretSync{
-		PlatformCluster:          values.PlatformCluster,
-		PlatformClusterNamespace: values.PlatformClusterNamespace,
+		Sources:                  values.Sources,
 		WorkloadCluster:          values.WorkloadCluster,
 		WorkloadClusterNamespace: valHelper.workloadNamespace(),
 	}
@@ -190,12 +326,16 @@ func CheckReadiness(ctx context.Context, values *Values) readiness.CheckResult {
 
 	hostClient := values.WorkloadCluster.Client()
 
-	aggregatedResult := readiness.NewReadyResult()
-	for _, mut := range []resources.Mutator[*appsv1.Deployment]{
+	mutators := []resources.Mutator[*appsv1.Deployment]{
 		newCentralDeploymentMutator(valHelper),
 		newMainDeploymentMutator(valHelper),
-		newWebhooksDeploymentMutator(valHelper),
-	} {
+	}
+	if !valHelper.areAllWebhooksDisabled() {
+		mutators = append(mutators, newWebhooksDeploymentMutator(valHelper))
+	}
+
+	aggregatedResult := readiness.NewReadyResult()
+	for _, mut := range mutators {
 		dp, err := resources.GetResource(ctx, hostClient, mut)
 		if err != nil {
 			return readiness.NewFailedResult(err)
@@ -206,3 +346,11 @@ func CheckReadiness(ctx context.Context, values *Values) readiness.CheckResult {
 
 	return aggregatedResult
 }
+
+// vpa returns the helper for the vertical pod autoscalers of the controllers and the webhooks server.
+func vpa(valHelper *valuesHelper) *verticalscaling.VPA {
+	return &verticalscaling.VPA{
+		WorkloadCluster:          valHelper.values.WorkloadCluster,
+		WorkloadClusterNamespace: valHelper.workloadNamespace(),
+	}
+}
@@ -1,6 +1,7 @@
 package landscaper_test
 
 import (
+	"strings"
 	"testing"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -10,15 +11,22 @@ import (
 	"github.com/openmcp-project/landscaper/apis/config/v1alpha1"
 	clustersv1alpha1 "github.com/openmcp-project/openmcp-operator/api/clusters/v1alpha1"
 	deploymentv1alpha1 "github.com/openmcp-project/openmcp-operator/api/provider/v1alpha1"
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	lsv1alpha2 "github.com/openmcp-project/service-provider-landscaper/api/v1alpha2"
 	"github.com/openmcp-project/service-provider-landscaper/internal/installer/landscaper"
 	"github.com/openmcp-project/service-provider-landscaper/internal/installer/rbac"
+	"github.com/openmcp-project/service-provider-landscaper/internal/shared/identity"
+	"github.com/openmcp-project/service-provider-landscaper/internal/shared/types"
 )
 
 const (
@@ -96,6 +104,257 @@ var _ = Describe("Landscaper Controller Installer", func() {
 
 		err = landscaper.InstallLandscaper(env.Ctx, values)
 		Expect(err).ToNot(HaveOccurred())
+
+		// the webhooks server runs at least two replicas and is protected by a pod disruption budget,
+		// the main controller runs a single replica by default
+		namespace := identity.Instance(instanceID).Namespace()
+		pdb := &policyv1.PodDisruptionBudget{}
+		Expect(env.Client().Get(env.Ctx, client.ObjectKey{Name: "landscaper-webhooks-server", Namespace: namespace}, pdb)).To(Succeed())
+		Expect(pdb.Spec.Selector.MatchLabels).ToNot(BeEmpty())
+		Expect(env.Client().Get(env.Ctx, client.ObjectKey{Name: "landscaper-controller-main", Namespace: namespace}, pdb)).ToNot(Succeed())
+
+		// the token is stored separately from the kubeconfig, so that it can be rotated without restarting the pods
+		kubeconfigSecret := &corev1.Secret{}
+		Expect(env.Client().Get(env.Ctx, client.ObjectKey{Name: "landscaper-controller-controller-mcp-kubeconfig", Namespace: namespace}, kubeconfigSecret)).To(Succeed())
+		Expect(string(kubeconfigSecret.Data["token"])).To(Equal("abcdefghijklmnopqrstuvwxyz1234567890"))
+		Expect(string(kubeconfigSecret.Data["kubeconfig"])).To(ContainSubstring("tokenFile: /app/ls/landscaper-controller-controller-mcp-kubeconfig/token"))
+		Expect(string(kubeconfigSecret.Data["kubeconfig"])).ToNot(ContainSubstring("abcdefghijklmnopqrstuvwxyz1234567890"))
+
+		mainDeployment := &appsv1.Deployment{}
+		Expect(env.Client().Get(env.Ctx, client.ObjectKey{Name: "landscaper-controller-main", Namespace: namespace}, mainDeployment)).To(Succeed())
+		annotations := mainDeployment.Spec.Template.Annotations
+
+		// the webhooks server uses its own kubeconfig
+		webhooksDeployment := &appsv1.Deployment{}
+		Expect(env.Client().Get(env.Ctx, client.ObjectKey{Name: "landscaper-webhooks-server", Namespace: namespace}, webhooksDeployment)).To(Succeed())
+		Expect(webhooksDeployment.Spec.Template.Spec.Volumes).To(ContainElement(HaveField("Name", "landscaper-controller-webhooks-mcp-kubeconfig")))
+		Expect(webhooksDeployment.Spec.Template.Spec.Volumes).ToNot(ContainElement(HaveField("Name", "landscaper-controller-controller-mcp-kubeconfig")))
+
+		values.Controller.MCPKubeconfig = strings.ReplaceAll(values.Controller.MCPKubeconfig, "abcdefghijklmnopqrstuvwxyz1234567890", "rotated")
+		Expect(landscaper.InstallLandscaper(env.Ctx, values)).To(Succeed())
+
+		Expect(env.Client().Get(env.Ctx, client.ObjectKeyFromObject(kubeconfigSecret), kubeconfigSecret)).To(Succeed())
+		Expect(string(kubeconfigSecret.Data["token"])).To(Equal("rotated"))
+		Expect(env.Client().Get(env.Ctx, client.ObjectKeyFromObject(mainDeployment), mainDeployment)).To(Succeed())
+		Expect(mainDeployment.Spec.Template.Annotations).To(Equal(annotations))
+	})
+
+	It("should mount the registry credentials into the main controller", func() {
+		env := buildTestEnvironment("test-01")
+
+		workloadCluster := clusters.NewTestClusterFromClient("workload", env.Client())
+		mcpCluster := clusters.NewTestClusterFromClient("mcp", env.Client())
+
+		kubeconfigMCP, err := rbac.TestKubeconfigAccessorImpl(env.Ctx, mcpCluster)
+		Expect(err).ToNot(HaveOccurred())
+
+		sizeLimit := resource.MustParse("2Gi")
+		values := &landscaper.Values{
+			Instance:        instanceID,
+			Version:         version,
+			WorkloadCluster: workloadCluster,
+			Controller: landscaper.ControllerValues{
+				MCPKubeconfig: string(kubeconfigMCP),
+				Image: lsv1alpha2.ImageConfiguration{
+					Image: "registry.test/landscaper-controller:" + version,
+				},
+			},
+			WebhooksServer: landscaper.WebhooksServerValues{
+				DisableWebhooks: []string{"all"},
+			},
+			OCI: &landscaper.OCIValues{
+				AllowPlainHttp: true,
+				Secrets: map[string][]byte{
+					"platform-registry.json": []byte(`{"auths":{"registry.test":{"auth":"dXNlcjpvbGQ="}}}`),
+				},
+			},
+			OCICache: &landscaper.OCICacheValues{
+				UseInMemoryOverlay: true,
+				SizeLimit:          &sizeLimit,
+			},
+		}
+
+		Expect(landscaper.InstallLandscaper(env.Ctx, values)).To(Succeed())
+
+		namespace := identity.Instance(instanceID).Namespace()
+		registriesSecret := &corev1.Secret{}
+		Expect(env.Client().Get(env.Ctx, client.ObjectKey{Name: "landscaper-controller-main-registries", Namespace: namespace}, registriesSecret)).To(Succeed())
+		Expect(registriesSecret.Data).To(HaveKey("platform-registry.json"))
+
+		configSecret := &corev1.Secret{}
+		Expect(env.Client().Get(env.Ctx, client.ObjectKey{Name: "landscaper-controller-config", Namespace: namespace}, configSecret)).To(Succeed())
+		Expect(string(configSecret.Data["config.yaml"])).To(ContainSubstring("/app/ls/registry/secrets/platform-registry.json"))
+		Expect(string(configSecret.Data["config.yaml"])).To(ContainSubstring("allowPlainHttp: true"))
+		Expect(string(configSecret.Data["config.yaml"])).To(ContainSubstring("useInMemoryOverlay: true"))
+
+		mainDeployment := &appsv1.Deployment{}
+		Expect(env.Client().Get(env.Ctx, client.ObjectKey{Name: "landscaper-controller-main", Namespace: namespace}, mainDeployment)).To(Succeed())
+		Expect(mainDeployment.Spec.Template.Spec.Volumes).To(ContainElement(And(
+			HaveField("Name", "ociregistry"),
+			HaveField("VolumeSource.Secret.SecretName", registriesSecret.Name),
+		)))
+		Expect(mainDeployment.Spec.Template.Spec.Volumes).To(ContainElement(And(
+			HaveField("Name", "oci-cache"),
+			HaveField("VolumeSource.EmptyDir.SizeLimit", HaveValue(Equal(sizeLimit))),
+		)))
+		Expect(mainDeployment.Spec.Template.Spec.Containers[0].VolumeMounts).To(ContainElement(And(
+			HaveField("Name", "ociregistry"),
+			HaveField("ReadOnly", true),
+		)))
+		checksum := mainDeployment.Spec.Template.Annotations["checksum/registrysecrets"]
+
+		// the central controller does not get the credentials
+		centralDeployment := &appsv1.Deployment{}
+		Expect(env.Client().Get(env.Ctx, client.ObjectKey{Name: "landscaper-controller", Namespace: namespace}, centralDeployment)).To(Succeed())
+		Expect(centralDeployment.Spec.Template.Spec.Volumes).ToNot(ContainElement(HaveField("Name", "ociregistry")))
+
+		// rotated credentials roll out the main controller
+		values.OCI.Secrets["platform-registry.json"] = []byte(`{"auths":{"registry.test":{"auth":"dXNlcjpuZXc="}}}`)
+		Expect(landscaper.InstallLandscaper(env.Ctx, values)).To(Succeed())
+		Expect(env.Client().Get(env.Ctx, client.ObjectKeyFromObject(mainDeployment), mainDeployment)).To(Succeed())
+		Expect(mainDeployment.Spec.Template.Annotations["checksum/registrysecrets"]).ToNot(Equal(checksum))
+
+		// without registries, the registries secret is removed
+		values.OCI = nil
+		Expect(landscaper.InstallLandscaper(env.Ctx, values)).To(Succeed())
+		Expect(env.Client().Get(env.Ctx, client.ObjectKeyFromObject(registriesSecret), registriesSecret)).ToNot(Succeed())
+	})
+
+	It("should back the cache of the main controller with a persistent volume claim", func() {
+		env := buildTestEnvironment("test-01")
+
+		workloadCluster := clusters.NewTestClusterFromClient("workload", env.Client())
+		mcpCluster := clusters.NewTestClusterFromClient("mcp", env.Client())
+
+		kubeconfigMCP, err := rbac.TestKubeconfigAccessorImpl(env.Ctx, mcpCluster)
+		Expect(err).ToNot(HaveOccurred())
+
+		values := &landscaper.Values{
+			Instance:        instanceID,
+			Version:         version,
+			WorkloadCluster: workloadCluster,
+			Controller: landscaper.ControllerValues{
+				MCPKubeconfig: string(kubeconfigMCP),
+				Image: lsv1alpha2.ImageConfiguration{
+					Image: "registry.test/landscaper-controller:" + version,
+				},
+				HPAMain: types.HPAValues{MaxReplicas: 3},
+			},
+			WebhooksServer: landscaper.WebhooksServerValues{
+				DisableWebhooks: []string{"all"},
+			},
+			OCICache: &landscaper.OCICacheValues{
+				Medium: corev1.StorageMediumMemory,
+				Persistent: &landscaper.PersistentCacheValues{
+					StorageClassName: ptr.To("fast"),
+					Size:             resource.MustParse("5Gi"),
+				},
+			},
+		}
+
+		Expect(landscaper.InstallLandscaper(env.Ctx, values)).To(Succeed())
+
+		namespace := identity.Instance(instanceID).Namespace()
+		pvc := &corev1.PersistentVolumeClaim{}
+		Expect(env.Client().Get(env.Ctx, client.ObjectKey{Name: "landscaper-controller-main-oci-cache", Namespace: namespace}, pvc)).To(Succeed())
+		Expect(pvc.Spec.StorageClassName).To(HaveValue(Equal("fast")))
+		Expect(pvc.Spec.Resources.Requests).To(HaveKeyWithValue(corev1.ResourceStorage, resource.MustParse("5Gi")))
+
+		mainDeployment := &appsv1.Deployment{}
+		Expect(env.Client().Get(env.Ctx, client.ObjectKey{Name: "landscaper-controller-main", Namespace: namespace}, mainDeployment)).To(Succeed())
+		Expect(mainDeployment.Spec.Strategy.Type).To(Equal(appsv1.RecreateDeploymentStrategyType))
+		// the claim is writable for the nonroot user of the controller
+		Expect(mainDeployment.Spec.Template.Spec.SecurityContext.FSGroup).To(HaveValue(Equal(types.DefaultFSGroup)))
+		Expect(mainDeployment.Spec.Template.Spec.Volumes).To(ContainElement(And(
+			HaveField("Name", "oci-cache"),
+			HaveField("VolumeSource.PersistentVolumeClaim.ClaimName", pvc.Name),
+		)))
+
+		// the claim can only be attached to one node, so that the main controller is not scaled out
+		mainHPA := &autoscalingv2.HorizontalPodAutoscaler{}
+		Expect(env.Client().Get(env.Ctx, client.ObjectKey{Name: "landscaper-controller-main", Namespace: namespace}, mainHPA)).To(Succeed())
+		Expect(mainHPA.Spec.MaxReplicas).To(Equal(int32(1)))
+		Expect(mainHPA.Spec.MinReplicas).To(HaveValue(Equal(int32(1))))
+
+		// the central controller keeps an emptyDir
+		centralDeployment := &appsv1.Deployment{}
+		Expect(env.Client().Get(env.Ctx, client.ObjectKey{Name: "landscaper-controller", Namespace: namespace}, centralDeployment)).To(Succeed())
+		Expect(centralDeployment.Spec.Template.Spec.Volumes).To(ContainElement(And(
+			HaveField("Name", "oci-cache"),
+			HaveField("VolumeSource.EmptyDir.Medium", corev1.StorageMediumMemory),
+		)))
+
+		// the cache can be enlarged, while the storage class is kept
+		values.OCICache.Persistent = &landscaper.PersistentCacheValues{
+			StorageClassName: ptr.To("slow"),
+			Size:             resource.MustParse("10Gi"),
+		}
+		Expect(landscaper.InstallLandscaper(env.Ctx, values)).To(Succeed())
+		Expect(env.Client().Get(env.Ctx, client.ObjectKeyFromObject(pvc), pvc)).To(Succeed())
+		Expect(pvc.Spec.StorageClassName).To(HaveValue(Equal("fast")))
+		Expect(pvc.Spec.Resources.Requests).To(HaveKeyWithValue(corev1.ResourceStorage, resource.MustParse("10Gi")))
+
+		// without persistence, the persistent volume claim is removed
+		values.OCICache.Persistent = nil
+		Expect(landscaper.InstallLandscaper(env.Ctx, values)).To(Succeed())
+		Expect(env.Client().Get(env.Ctx, client.ObjectKeyFromObject(pvc), pvc)).ToNot(Succeed())
+		Expect(env.Client().Get(env.Ctx, client.ObjectKeyFromObject(mainDeployment), mainDeployment)).To(Succeed())
+		Expect(mainDeployment.Spec.Template.Spec.Volumes).To(ContainElement(And(
+			HaveField("Name", "oci-cache"),
+			HaveField("VolumeSource.EmptyDir.Medium", corev1.StorageMediumMemory),
+		)))
+	})
+
+	It("should apply the scheduling settings to the pods of the controllers", func() {
+		env := buildTestEnvironment("test-01")
+
+		workloadCluster := clusters.NewTestClusterFromClient("workload", env.Client())
+		mcpCluster := clusters.NewTestClusterFromClient("mcp", env.Client())
+
+		kubeconfigMCP, err := rbac.TestKubeconfigAccessorImpl(env.Ctx, mcpCluster)
+		Expect(err).ToNot(HaveOccurred())
+
+		toleration := corev1.Toleration{Key: "dedicated", Operator: corev1.TolerationOpEqual, Value: "tenant-controllers", Effect: corev1.TaintEffectNoSchedule}
+		values := &landscaper.Values{
+			Instance:        instanceID,
+			Version:         version,
+			WorkloadCluster: workloadCluster,
+			Controller: landscaper.ControllerValues{
+				MCPKubeconfig: string(kubeconfigMCP),
+				Image: lsv1alpha2.ImageConfiguration{
+					Image: "registry.test/landscaper-controller:" + version,
+				},
+			},
+			WebhooksServer: landscaper.WebhooksServerValues{
+				DisableWebhooks: []string{"all"},
+			},
+			Scheduling: &types.SchedulingValues{
+				NodeSelector:      map[string]string{"pool": "tenant-controllers"},
+				Tolerations:       []corev1.Toleration{toleration},
+				PriorityClassName: "tenant-controllers",
+				TopologySpreadConstraints: []corev1.TopologySpreadConstraint{
+					{MaxSkew: 2, TopologyKey: "topology.kubernetes.io/zone", WhenUnsatisfiable: corev1.DoNotSchedule},
+				},
+			},
+		}
+
+		Expect(landscaper.InstallLandscaper(env.Ctx, values)).To(Succeed())
+
+		namespace := identity.Instance(instanceID).Namespace()
+		for _, name := range []string{"landscaper-controller", "landscaper-controller-main"} {
+			deployment := &appsv1.Deployment{}
+			Expect(env.Client().Get(env.Ctx, client.ObjectKey{Name: name, Namespace: namespace}, deployment)).To(Succeed())
+			podSpec := deployment.Spec.Template.Spec
+			Expect(podSpec.NodeSelector).To(HaveKeyWithValue("pool", "tenant-controllers"))
+			Expect(podSpec.Tolerations).To(ConsistOf(toleration))
+			Expect(podSpec.PriorityClassName).To(Equal("tenant-controllers"))
+			Expect(podSpec.TopologySpreadConstraints).To(ConsistOf(And(
+				HaveField("MaxSkew", int32(2)),
+				HaveField("TopologyKey", "topology.kubernetes.io/zone"),
+				HaveField("WhenUnsatisfiable", corev1.DoNotSchedule),
+				HaveField("LabelSelector.MatchLabels", HaveKeyWithValue("landscaper.openmcp-project.cloud/topology", name)),
+			)))
+		}
 	})
 
 	It("should uninstall the landscaper controllers", func() {
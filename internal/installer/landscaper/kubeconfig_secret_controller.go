@@ -9,9 +9,7 @@ func newControllerMCPKubeconfigSecretMutator(b *valuesHelper) resources.Mutator[
 	m := resources.NewSecretMutator(
 		b.controllerMCPKubeconfigSecretName(),
 		b.workloadNamespace(),
-		map[string][]byte{
-			"kubeconfig": []byte(b.values.Controller.MCPKubeconfig),
-		},
+		b.controllerMCPKubeconfig.Data(),
 		v1.SecretTypeOpaque)
 	m.MetadataMutator().WithLabels(b.controllerComponent.Labels())
 	return m
@@ -21,9 +19,7 @@ func newControllerWorkloadKubeconfigSecretMutator(b *valuesHelper) resources.Mut
 	m := resources.NewSecretMutator(
 		b.controllerWorkloadKubeconfigSecretName(),
 		b.workloadNamespace(),
-		map[string][]byte{
-			"kubeconfig": []byte(b.values.Controller.WorkloadKubeconfig),
-		},
+		b.controllerWorkloadKubeconfig.Data(),
 		v1.SecretTypeOpaque)
 	m.MetadataMutator().WithLabels(b.controllerComponent.Labels())
 	return m
@@ -9,9 +9,7 @@ func newWebhooksKubeconfigSecretMutator(b *valuesHelper) resources.Mutator[*v1.S
 	m := resources.NewSecretMutator(
 		b.webhooksKubeconfigSecretName(),
 		b.workloadNamespace(),
-		map[string][]byte{
-			"kubeconfig": []byte(b.values.WebhooksServer.MCPKubeconfig),
-		},
+		b.webhooksMCPKubeconfig.Data(),
 		v1.SecretTypeOpaque)
 	m.MetadataMutator().WithLabels(b.webhooksComponent.Labels())
 	return m
@@ -0,0 +1,63 @@
+package landscaper
+
+import (
+	"fmt"
+
+	core "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+
+	"github.com/openmcp-project/controller-utils/pkg/resources"
+)
+
+type webhooksNetworkPolicyMutator struct {
+	*valuesHelper
+	metadata resources.MetadataMutator
+}
+
+var _ resources.Mutator[*networkingv1.NetworkPolicy] = &webhooksNetworkPolicyMutator{}
+
+// newWebhooksNetworkPolicyMutator returns the mutator of the network policy which allows the gateway
+// to reach the webhooks server on its service port.
+func newWebhooksNetworkPolicyMutator(b *valuesHelper) resources.Mutator[*networkingv1.NetworkPolicy] {
+	return &webhooksNetworkPolicyMutator{valuesHelper: b, metadata: resources.NewMetadataMutator()}
+}
+
+func (m *webhooksNetworkPolicyMutator) String() string {
+	return fmt.Sprintf("networkpolicy %s/%s", m.workloadNamespace(), m.landscaperWebhooksFullName())
+}
+
+func (m *webhooksNetworkPolicyMutator) Empty() *networkingv1.NetworkPolicy {
+	return &networkingv1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      m.landscaperWebhooksFullName(),
+			Namespace: m.workloadNamespace(),
+		},
+	}
+}
+
+func (m *webhooksNetworkPolicyMutator) MetadataMutator() resources.MetadataMutator {
+	return m.metadata
+}
+
+func (m *webhooksNetworkPolicyMutator) Mutate(r *networkingv1.NetworkPolicy) error {
+	r.Labels = m.webhooksComponent.Labels()
+	r.Spec = networkingv1.NetworkPolicySpec{
+		PodSelector: metav1.LabelSelector{MatchLabels: m.webhooksComponent.SelectorLabels()},
+		PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress},
+		Ingress: []networkingv1.NetworkPolicyIngressRule{
+			{
+				From: m.values.NetworkPolicies.Gateway,
+				Ports: []networkingv1.NetworkPolicyPort{
+					{
+						Protocol: ptr.To(core.ProtocolTCP),
+						Port:     ptr.To(intstr.FromInt32(m.values.WebhooksServer.ServicePort)),
+					},
+				},
+			},
+		},
+	}
+	return nil
+}
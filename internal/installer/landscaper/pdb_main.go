@@ -0,0 +1,50 @@
+package landscaper
+
+import (
+	"fmt"
+
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+
+	"github.com/openmcp-project/controller-utils/pkg/resources"
+)
+
+type mainPDBMutator struct {
+	*valuesHelper
+	metadata resources.MetadataMutator
+}
+
+var _ resources.Mutator[*policyv1.PodDisruptionBudget] = &mainPDBMutator{}
+
+func newMainPDBMutator(b *valuesHelper) resources.Mutator[*policyv1.PodDisruptionBudget] {
+	return &mainPDBMutator{valuesHelper: b, metadata: resources.NewMetadataMutator()}
+}
+
+func (m *mainPDBMutator) String() string {
+	return fmt.Sprintf("pdb %s/%s", m.workloadNamespace(), m.landscaperMainFullName())
+}
+
+func (m *mainPDBMutator) Empty() *policyv1.PodDisruptionBudget {
+	return &policyv1.PodDisruptionBudget{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      m.landscaperMainFullName(),
+			Namespace: m.workloadNamespace(),
+		},
+	}
+}
+
+func (m *mainPDBMutator) MetadataMutator() resources.MetadataMutator {
+	return m.metadata
+}
+
+func (m *mainPDBMutator) Mutate(r *policyv1.PodDisruptionBudget) error {
+	r.Labels = m.controllerMainComponent.Labels()
+	r.Spec = policyv1.PodDisruptionBudgetSpec{
+		MaxUnavailable:             ptr.To(intstr.FromInt32(1)),
+		Selector:                   &metav1.LabelSelector{MatchLabels: m.controllerMainComponent.SelectorLabels()},
+		UnhealthyPodEvictionPolicy: ptr.To(policyv1.AlwaysAllow),
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+package landscaper
+
+import (
+	"fmt"
+
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+
+	"github.com/openmcp-project/controller-utils/pkg/resources"
+)
+
+type webhooksPDBMutator struct {
+	*valuesHelper
+	metadata resources.MetadataMutator
+}
+
+var _ resources.Mutator[*policyv1.PodDisruptionBudget] = &webhooksPDBMutator{}
+
+func newWebhooksPDBMutator(b *valuesHelper) resources.Mutator[*policyv1.PodDisruptionBudget] {
+	return &webhooksPDBMutator{valuesHelper: b, metadata: resources.NewMetadataMutator()}
+}
+
+func (m *webhooksPDBMutator) String() string {
+	return fmt.Sprintf("pdb %s/%s", m.workloadNamespace(), m.landscaperWebhooksFullName())
+}
+
+func (m *webhooksPDBMutator) Empty() *policyv1.PodDisruptionBudget {
+	return &policyv1.PodDisruptionBudget{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      m.landscaperWebhooksFullName(),
+			Namespace: m.workloadNamespace(),
+		},
+	}
+}
+
+func (m *webhooksPDBMutator) MetadataMutator() resources.MetadataMutator {
+	return m.metadata
+}
+
+func (m *webhooksPDBMutator) Mutate(r *policyv1.PodDisruptionBudget) error {
+	r.Labels = m.webhooksComponent.Labels()
+	r.Spec = policyv1.PodDisruptionBudgetSpec{
+		MaxUnavailable:             ptr.To(intstr.FromInt32(1)),
+		Selector:                   &metav1.LabelSelector{MatchLabels: m.webhooksComponent.SelectorLabels()},
+		UnhealthyPodEvictionPolicy: ptr.To(policyv1.AlwaysAllow),
+	}
+	return nil
+}
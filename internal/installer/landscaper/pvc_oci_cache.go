@@ -0,0 +1,56 @@
+package landscaper
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/openmcp-project/controller-utils/pkg/resources"
+)
+
+type ociCachePVCMutator struct {
+	*valuesHelper
+	metadata resources.MetadataMutator
+}
+
+var _ resources.Mutator[*corev1.PersistentVolumeClaim] = &ociCachePVCMutator{}
+
+func newOCICachePVCMutator(b *valuesHelper) resources.Mutator[*corev1.PersistentVolumeClaim] {
+	return &ociCachePVCMutator{valuesHelper: b, metadata: resources.NewMetadataMutator()}
+}
+
+func (m *ociCachePVCMutator) String() string {
+	return fmt.Sprintf("persistent volume claim %s/%s", m.workloadNamespace(), m.ociCachePVCName())
+}
+
+func (m *ociCachePVCMutator) Empty() *corev1.PersistentVolumeClaim {
+	return &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      m.ociCachePVCName(),
+			Namespace: m.workloadNamespace(),
+		},
+	}
+}
+
+func (m *ociCachePVCMutator) MetadataMutator() resources.MetadataMutator {
+	return m.metadata
+}
+
+func (m *ociCachePVCMutator) Mutate(r *corev1.PersistentVolumeClaim) error {
+	persistent := m.values.OCICache.Persistent
+	r.Labels = m.controllerMainComponent.Labels()
+
+	// Except for the requested storage, the spec of a persistent volume claim is immutable.
+	if r.ResourceVersion == "" {
+		r.Spec = corev1.PersistentVolumeClaimSpec{
+			AccessModes:      []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+			StorageClassName: persistent.StorageClassName,
+		}
+	}
+	if r.Spec.Resources.Requests == nil {
+		r.Spec.Resources.Requests = corev1.ResourceList{}
+	}
+	r.Spec.Resources.Requests[corev1.ResourceStorage] = persistent.Size
+	return nil
+}
@@ -0,0 +1,20 @@
+package landscaper
+
+import (
+	"github.com/openmcp-project/controller-utils/pkg/resources"
+	v1 "k8s.io/api/core/v1"
+)
+
+func newRegistriesSecretMutator(b *valuesHelper) resources.Mutator[*v1.Secret] {
+	var data map[string][]byte
+	if b.values.OCI != nil {
+		data = b.values.OCI.Secrets
+	}
+	m := resources.NewSecretMutator(
+		b.registriesSecretName(),
+		b.workloadNamespace(),
+		data,
+		v1.SecretTypeOpaque)
+	m.MetadataMutator().WithLabels(b.controllerMainComponent.Labels())
+	return m
+}
@@ -1,6 +1,7 @@
 package landscaper
 
 import (
+	"slices"
 	"time"
 
 	"github.com/openmcp-project/controller-utils/pkg/clusters"
@@ -12,23 +13,55 @@ import (
 
 	api "github.com/openmcp-project/service-provider-landscaper/api/v1alpha2"
 	"github.com/openmcp-project/service-provider-landscaper/internal/shared/identity"
+	"github.com/openmcp-project/service-provider-landscaper/internal/shared/sources"
 	"github.com/openmcp-project/service-provider-landscaper/internal/shared/types"
 )
 
 type Values struct {
-	Instance                 identity.Instance `json:"instance,omitempty"`
-	Version                  string            `json:"version,omitempty"`
-	PlatformCluster          *clusters.Cluster
-	PlatformClusterNamespace string
-	WorkloadCluster          *clusters.Cluster
-	VerbosityLevel           string                           `json:"verbosityLevel,omitempty"`
-	Configuration            v1alpha1.LandscaperConfiguration `json:"configuration,omitempty"`
-	Controller               ControllerValues                 `json:"controller,omitempty"`
-	WebhooksServer           WebhooksServerValues             `json:"webhooksServer,omitempty"`
-	PodSecurityContext       *core.PodSecurityContext         `json:"podSecurityContext,omitempty"`
-	SecurityContext          *core.SecurityContext            `json:"securityContext,omitempty"`
+	Instance           identity.Instance `json:"instance,omitempty"`
+	Version            string            `json:"version,omitempty"`
+	Sources            *sources.Resolver
+	WorkloadCluster    *clusters.Cluster
+	VerbosityLevel     string                           `json:"verbosityLevel,omitempty"`
+	Configuration      v1alpha1.LandscaperConfiguration `json:"configuration,omitempty"`
+	Controller         ControllerValues                 `json:"controller,omitempty"`
+	WebhooksServer     WebhooksServerValues             `json:"webhooksServer,omitempty"`
+	PodSecurityContext *core.PodSecurityContext         `json:"podSecurityContext,omitempty"`
+	SecurityContext    *core.SecurityContext            `json:"securityContext,omitempty"`
+	Scheduling         *types.SchedulingValues          `json:"scheduling,omitempty"`
+	VerticalScaling    *types.VerticalScalingValues     `json:"verticalScaling,omitempty"`
+	NetworkPolicies    *types.NetworkPolicyValues       `json:"networkPolicies,omitempty"` // optional - if not set, no network policies are created
+	OCI                *OCIValues                       `json:"oci,omitempty"`
+	OCICache           *OCICacheValues                  `json:"ociCache,omitempty"`
 }
 
+type OCIValues struct {
+	AllowPlainHttp     bool `json:"allowPlainHttp,omitempty"`
+	InsecureSkipVerify bool `json:"insecureSkipVerify,omitempty"`
+	// Secrets maps file names to the content of docker config files with registry credentials.
+	Secrets map[string][]byte `json:"secrets,omitempty"`
+}
+
+type OCICacheValues struct {
+	UseInMemoryOverlay bool               `json:"useInMemoryOverlay,omitempty"`
+	SizeLimit          *resource.Quantity `json:"sizeLimit,omitempty"` // optional - if not set, the cache size is not limited
+	Medium             core.StorageMedium `json:"medium,omitempty"`    // optional - if not set, the default medium of the node is used
+	// Persistent backs the cache of the main controller with a persistent volume claim instead of an emptyDir.
+	Persistent *PersistentCacheValues `json:"persistent,omitempty"`
+}
+
+type PersistentCacheValues struct {
+	StorageClassName *string           `json:"storageClassName,omitempty"` // optional - if not set, the default storage class is used
+	Size             resource.Quantity `json:"size"`
+}
+
+const (
+	// ociCachePath is the directory in which the cache volume is mounted.
+	ociCachePath = "/app/ls/oci-cache"
+	// registrySecretsPath is the directory in which the docker config files of the registries secret are mounted.
+	registrySecretsPath = "/app/ls/registry/secrets"
+)
+
 type ServiceAccountValues struct {
 	Create bool `json:"create,omitempty"`
 }
@@ -118,7 +151,9 @@ func (v *Values) Default() error {
 		v.Controller.Contexts.Workers = 5
 	}
 	v.Controller.Contexts.Config.Default.Disable = false
-	v.Controller.Contexts.Config.Default.ExcludedNamespaces = []string{"kube-system"}
+	if !slices.Contains(v.Controller.Contexts.Config.Default.ExcludedNamespaces, "kube-system") {
+		v.Controller.Contexts.Config.Default.ExcludedNamespaces = append([]string{"kube-system"}, v.Controller.Contexts.Config.Default.ExcludedNamespaces...)
+	}
 
 	if v.Controller.Service == nil {
 		v.Controller.Service = &ServiceValues{}
@@ -170,20 +205,18 @@ func (v *Values) Default() error {
 			core.ResourceMemory: memory,
 		}
 	}
-	if v.Controller.HPAMain.MaxReplicas == 0 {
+	v.Controller.HPAMain.Default(1, 1)
+	// a persistent cache volume can only be attached to one node, so that the main controller must not scale out
+	if v.OCICache != nil && v.OCICache.Persistent != nil {
+		v.Controller.HPAMain.MinReplicas = ptr.To[int32](1)
 		v.Controller.HPAMain.MaxReplicas = 1
 	}
-	if v.Controller.HPAMain.AverageCpuUtilization == nil {
-		v.Controller.HPAMain.AverageCpuUtilization = ptr.To(int32(80))
-	}
-	if v.Controller.HPAMain.AverageMemoryUtilization == nil {
-		v.Controller.HPAMain.AverageMemoryUtilization = ptr.To(int32(80))
-	}
 
 	if v.Controller.DeployItemTimeouts == nil {
-		v.Controller.DeployItemTimeouts = &v1alpha1.DeployItemTimeouts{
-			Pickup: &lscore.Duration{Duration: 60 * time.Minute},
-		}
+		v.Controller.DeployItemTimeouts = &v1alpha1.DeployItemTimeouts{}
+	}
+	if v.Controller.DeployItemTimeouts.Pickup == nil {
+		v.Controller.DeployItemTimeouts.Pickup = &lscore.Duration{Duration: 60 * time.Minute}
 	}
 	if v.WebhooksServer.Service == nil {
 		v.WebhooksServer.Service = &ServiceValues{}
@@ -215,14 +248,13 @@ func (v *Values) Default() error {
 		}
 	}
 
-	if v.WebhooksServer.HPA.MaxReplicas == 0 {
-		v.WebhooksServer.HPA.MaxReplicas = 2
-	}
-	if v.WebhooksServer.HPA.AverageCpuUtilization == nil {
-		v.WebhooksServer.HPA.AverageCpuUtilization = ptr.To(int32(80))
+	v.WebhooksServer.HPA.Default(2, 2)
+
+	if v.PodSecurityContext == nil {
+		v.PodSecurityContext = types.DefaultPodSecurityContext()
 	}
-	if v.WebhooksServer.HPA.AverageMemoryUtilization == nil {
-		v.WebhooksServer.HPA.AverageMemoryUtilization = ptr.To(int32(80))
+	if v.SecurityContext == nil {
+		v.SecurityContext = types.DefaultSecurityContext()
 	}
 
 	return nil
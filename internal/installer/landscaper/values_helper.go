@@ -4,14 +4,17 @@ import (
 	"crypto/sha256"
 	"encoding/hex"
 	"fmt"
+	"path"
 	"slices"
 
 	"github.com/openmcp-project/landscaper/apis/config/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/yaml"
 
 	"github.com/openmcp-project/service-provider-landscaper/internal/shared/identity"
+	"github.com/openmcp-project/service-provider-landscaper/internal/shared/kubeconfigs"
 )
 
 const (
@@ -30,6 +33,12 @@ type valuesHelper struct {
 	config     *v1alpha1.LandscaperConfiguration
 	configYaml []byte
 	configHash string
+
+	registrySecretsHash string
+
+	controllerMCPKubeconfig      *kubeconfigs.Secret
+	controllerWorkloadKubeconfig *kubeconfigs.Secret
+	webhooksMCPKubeconfig        *kubeconfigs.Secret
 }
 
 func newValuesHelper(values *Values) (*valuesHelper, error) {
@@ -51,6 +60,10 @@ func newValuesHelper(values *Values) (*valuesHelper, error) {
 		return nil, err
 	}
 
+	if err := h.computeKubeconfigs(); err != nil {
+		return nil, err
+	}
+
 	return h, nil
 }
 
@@ -91,6 +104,10 @@ func (h *valuesHelper) configSecretName() string {
 	return h.controllerComponent.NamespacedResourceName("config")
 }
 
+func (h *valuesHelper) registriesSecretName() string {
+	return h.controllerMainComponent.NamespacedResourceName("registries")
+}
+
 func (h *valuesHelper) controllerMCPKubeconfigSecretName() string {
 	return h.controllerComponent.NamespacedResourceName("controller-mcp-kubeconfig")
 }
@@ -107,6 +124,18 @@ func (h *valuesHelper) areAllWebhooksDisabled() bool {
 	return slices.Contains(h.values.WebhooksServer.DisableWebhooks, allWebhooks)
 }
 
+// computeKubeconfigs computes the content of the kubeconfig secrets, in which the tokens are stored separately.
+func (h *valuesHelper) computeKubeconfigs() (err error) {
+	if h.controllerMCPKubeconfig, err = kubeconfigs.NewSecret([]byte(h.values.Controller.MCPKubeconfig), h.controllerMCPKubeconfigSecretName()); err != nil {
+		return err
+	}
+	if h.controllerWorkloadKubeconfig, err = kubeconfigs.NewSecret([]byte(h.values.Controller.WorkloadKubeconfig), h.controllerWorkloadKubeconfigSecretName()); err != nil {
+		return err
+	}
+	h.webhooksMCPKubeconfig, err = kubeconfigs.NewSecret([]byte(h.values.WebhooksServer.MCPKubeconfig), h.webhooksKubeconfigSecretName())
+	return err
+}
+
 func (h *valuesHelper) computeConfiguration() (err error) {
 	h.config = &v1alpha1.LandscaperConfiguration{
 		TypeMeta: metav1.TypeMeta{
@@ -120,14 +149,7 @@ func (h *valuesHelper) computeConfiguration() (err error) {
 			Contexts:      h.values.Controller.Contexts,
 		},
 		Registry: v1alpha1.RegistryConfiguration{
-			OCI: &v1alpha1.OCIConfiguration{
-				Cache: &v1alpha1.OCICacheConfiguration{
-					UseInMemoryOverlay: false,
-					Path:               "/app/ls/oci-cache/",
-				},
-				AllowPlainHttp:     false,
-				InsecureSkipVerify: false,
-			},
+			OCI: h.ociConfiguration(),
 		},
 		CrdManagement: v1alpha1.CrdManagementConfiguration{
 			DeployCustomResourceDefinitions: ptr.To(true),
@@ -155,5 +177,54 @@ func (h *valuesHelper) computeConfiguration() (err error) {
 	hash := sha256.Sum256(h.configYaml)
 	h.configHash = hex.EncodeToString(hash[:])
 
+	registrySecretsYaml, err := yaml.Marshal(h.values.OCI)
+	if err != nil {
+		return fmt.Errorf("failed to marshal landscaper registry secrets: %w", err)
+	}
+	hash = sha256.Sum256(registrySecretsYaml)
+	h.registrySecretsHash = hex.EncodeToString(hash[:])
+
 	return nil
 }
+
+func (h *valuesHelper) ociCachePVCName() string {
+	return h.controllerMainComponent.NamespacedResourceName("oci-cache")
+}
+
+// hasPersistentOCICache returns true if the cache of the main controller is backed by a persistent volume claim.
+func (h *valuesHelper) hasPersistentOCICache() bool {
+	return h.values.OCICache != nil && h.values.OCICache.Persistent != nil
+}
+
+// ociCacheVolumeSource returns the emptyDir volume source of the cache for OCI artifacts.
+func (h *valuesHelper) ociCacheVolumeSource() corev1.VolumeSource {
+	emptyDir := &corev1.EmptyDirVolumeSource{}
+	if h.values.OCICache != nil {
+		emptyDir.SizeLimit = h.values.OCICache.SizeLimit
+		emptyDir.Medium = h.values.OCICache.Medium
+	}
+	return corev1.VolumeSource{EmptyDir: emptyDir}
+}
+
+func (h *valuesHelper) ociConfiguration() *v1alpha1.OCIConfiguration {
+	oci := &v1alpha1.OCIConfiguration{
+		Cache: &v1alpha1.OCICacheConfiguration{
+			Path: ociCachePath + "/",
+		},
+	}
+
+	if h.values.OCICache != nil {
+		oci.Cache.UseInMemoryOverlay = h.values.OCICache.UseInMemoryOverlay
+	}
+
+	if h.values.OCI != nil {
+		for key := range h.values.OCI.Secrets {
+			oci.ConfigFiles = append(oci.ConfigFiles, path.Join(registrySecretsPath, key))
+		}
+		slices.Sort(oci.ConfigFiles)
+		oci.AllowPlainHttp = h.values.OCI.AllowPlainHttp
+		oci.InsecureSkipVerify = h.values.OCI.InsecureSkipVerify
+	}
+
+	return oci
+}
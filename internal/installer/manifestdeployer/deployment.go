@@ -1,8 +1,6 @@
 package manifestdeployer
 
 import (
-	"crypto/sha256"
-	"encoding/hex"
 	"fmt"
 	"strconv"
 
@@ -15,6 +13,8 @@ import (
 	"github.com/openmcp-project/controller-utils/pkg/resources"
 
 	configmapsync "github.com/openmcp-project/service-provider-landscaper/internal/shared/configmaps"
+	"github.com/openmcp-project/service-provider-landscaper/internal/shared/kubeconfigs"
+	"github.com/openmcp-project/service-provider-landscaper/internal/shared/types"
 )
 
 type deploymentMutator struct {
@@ -76,6 +76,7 @@ func (d *deploymentMutator) Mutate(r *appsv1.Deployment) error {
 			},
 		},
 	}
+	d.values.Scheduling.ApplyTo(&r.Spec.Template.Spec, d.manifestDeployerComponent.TopologyLabels())
 	return nil
 }
 
@@ -88,12 +89,9 @@ func (d *deploymentMutator) strategy() appsv1.DeploymentStrategy {
 }
 
 func (d *deploymentMutator) templateAnnotations() map[string]string {
-	hash := sha256.Sum256([]byte(d.values.MCPClusterKubeconfig))
-	mcpKubeconfigHash := hex.EncodeToString(hash[:])
-
 	annotations := map[string]string{
 		"checksum/config":        d.configHash,
-		"checksum/mcpKubeconfig": mcpKubeconfigHash,
+		"checksum/mcpKubeconfig": d.mcpKubeconfig.Hash(),
 	}
 	return annotations
 }
@@ -149,6 +147,8 @@ func (d *deploymentMutator) volumes() []corev1.Volume {
 		volumes = append(volumes, caVolume)
 	}
 
+	volumes = append(volumes, types.TmpVolume())
+
 	return volumes
 }
 
@@ -160,7 +160,7 @@ func (d *deploymentMutator) volumeMounts() []corev1.VolumeMount {
 		},
 		{
 			Name:      d.mcpKubeconfigSecretName(),
-			MountPath: fmt.Sprint("/app/ls/", d.mcpKubeconfigSecretName()),
+			MountPath: kubeconfigs.MountPath(d.mcpKubeconfigSecretName()),
 		},
 	}
 
@@ -172,6 +172,8 @@ func (d *deploymentMutator) volumeMounts() []corev1.VolumeMount {
 		})
 	}
 
+	volumeMounts = append(volumeMounts, types.TmpVolumeMount())
+
 	return volumeMounts
 }
 
@@ -189,7 +191,7 @@ func (d *deploymentMutator) env() []corev1.EnvVar {
 	envVars := []corev1.EnvVar{
 		{
 			Name:  "KUBECONFIG",
-			Value: fmt.Sprintf("/app/ls/%s/kubeconfig", d.mcpKubeconfigSecretName()),
+			Value: kubeconfigs.KubeconfigPath(d.mcpKubeconfigSecretName()),
 		},
 		{
 			Name: "MY_POD_NAME",
@@ -7,6 +7,7 @@ import (
 	"github.com/openmcp-project/controller-utils/pkg/resources"
 
 	imgpullsecrets "github.com/openmcp-project/service-provider-landscaper/internal/shared/imagepullsecrets"
+	"github.com/openmcp-project/service-provider-landscaper/internal/shared/verticalscaling"
 )
 
 type Exports struct {
@@ -22,13 +23,12 @@ func InstallManifestDeployer(ctx context.Context, values *Values) (*Exports, err
 
 	workloadClient := values.WorkloadCluster.Client()
 
-	if err := resources.CreateOrUpdateResource(ctx, workloadClient, resources.NewNamespaceMutator(valHelper.workloadNamespace())); err != nil {
+	if err := resources.CreateOrUpdateResource(ctx, workloadClient, values.Instance.NamespaceMutator()); err != nil {
 		return nil, err
 	}
 
 	imgPullSecretsSync := imgpullsecrets.SecretSync{
-		PlatformCluster:          values.PlatformCluster,
-		PlatformClusterNamespace: values.PlatformClusterNamespace,
+		Sources:                  values.Sources,
 		WorkloadCluster:          values.WorkloadCluster,
 		WorkloadClusterNamespace: valHelper.workloadNamespace(),
 	}
@@ -50,10 +50,22 @@ func InstallManifestDeployer(ctx context.Context, values *Values) (*Exports, err
 		return nil, err
 	}
 
+	if err := vpa(valHelper).Apply(ctx, valHelper.manifestDeployerComponent, verticalscaling.ForAutoscaler(values.VerticalScaling, &values.HPA)); err != nil {
+		return nil, err
+	}
+
 	if err := resources.CreateOrUpdateResource(ctx, workloadClient, newDeploymentMutator(valHelper).WithImagePullSecrets(imagePullSecrets).Convert()); err != nil {
 		return nil, err
 	}
 
+	if valHelper.values.HPA.MaxReplicas > 1 {
+		if err := resources.CreateOrUpdateResource(ctx, workloadClient, newPDBMutator(valHelper)); err != nil {
+			return nil, err
+		}
+	} else if err := resources.DeleteResource(ctx, workloadClient, newPDBMutator(valHelper)); err != nil {
+		return nil, err
+	}
+
 	return &Exports{
 		// needed for health checks
 		DeploymentName: valHelper.manifestDeployerComponent.NamespacedDefaultResourceName(),
@@ -73,6 +85,14 @@ func UninstallManifestDeployer(ctx context.Context, values *Values) error {
 		return err
 	}
 
+	if err := resources.DeleteResource(ctx, workloadClient, newPDBMutator(valHelper)); err != nil {
+		return err
+	}
+
+	if err := vpa(valHelper).Delete(ctx, valHelper.manifestDeployerComponent); err != nil {
+		return err
+	}
+
 	if err := resources.DeleteResource(ctx, workloadClient, newHPAMutator(valHelper)); err != nil {
 		return err
 	}
@@ -86,8 +106,7 @@ func UninstallManifestDeployer(ctx context.Context, values *Values) error {
 	}
 
 	imgPullSecretsSync := imgpullsecrets.SecretSync{
-		PlatformCluster:          values.PlatformCluster,
-		PlatformClusterNamespace: values.PlatformClusterNamespace,
+		Sources:                  values.Sources,
 		WorkloadCluster:          values.WorkloadCluster,
 		WorkloadClusterNamespace: valHelper.workloadNamespace(),
 	}
@@ -112,3 +131,11 @@ func CheckReadiness(ctx context.Context, values *Values) readiness.CheckResult {
 	}
 	return readiness.CheckDeployment(dp)
 }
+
+// vpa returns the helper for the vertical pod autoscaler of the deployer.
+func vpa(valHelper *valuesHelper) *verticalscaling.VPA {
+	return &verticalscaling.VPA{
+		WorkloadCluster:          valHelper.values.WorkloadCluster,
+		WorkloadClusterNamespace: valHelper.workloadNamespace(),
+	}
+}
@@ -11,27 +11,29 @@ import (
 
 	api "github.com/openmcp-project/service-provider-landscaper/api/v1alpha2"
 	"github.com/openmcp-project/service-provider-landscaper/internal/shared/identity"
+	"github.com/openmcp-project/service-provider-landscaper/internal/shared/sources"
 	"github.com/openmcp-project/service-provider-landscaper/internal/shared/types"
 )
 
 type Values struct {
-	Instance                 identity.Instance `json:"instance,omitempty"`
-	Version                  string            `json:"version,omitempty"`
-	PlatformCluster          *clusters.Cluster
-	PlatformClusterNamespace string `json:"platformClusterNamespace,omitempty"`
-	WorkloadCluster          *clusters.Cluster
-	VerbosityLevel           string                     `json:"verbosityLevel,omitempty"`
-	MCPClusterKubeconfig     string                     `json:"mcpClusterKubeconfig,omitempty"`
-	Image                    api.ImageConfiguration     `json:"image,omitempty"`
-	ReplicaCount             *int32                     `json:"replicaCount,omitempty"`
-	Resources                core.ResourceRequirements  `json:"resources,omitempty"`
-	PodSecurityContext       *core.PodSecurityContext   `json:"podSecurityContext,omitempty"`
-	SecurityContext          *core.SecurityContext      `json:"securityContext,omitempty"`
-	Configuration            v1alpha2.Configuration     `json:"configuration,omitempty"`
-	WorkloadClientSettings   *ClientSettings            `json:"workloadClientSettings,omitempty"`
-	MCPClientSettings        *ClientSettings            `json:"mcpClientSettings,omitempty"`
-	HPA                      types.HPAValues            `json:"hpa,omitempty"`
-	CAConfigMap              *core.ConfigMapKeySelector `json:"caConfigMap,omitempty"`
+	Instance               identity.Instance `json:"instance,omitempty"`
+	Version                string            `json:"version,omitempty"`
+	Sources                *sources.Resolver
+	WorkloadCluster        *clusters.Cluster
+	VerbosityLevel         string                       `json:"verbosityLevel,omitempty"`
+	MCPClusterKubeconfig   string                       `json:"mcpClusterKubeconfig,omitempty"`
+	Image                  api.ImageConfiguration       `json:"image,omitempty"`
+	ReplicaCount           *int32                       `json:"replicaCount,omitempty"`
+	Resources              core.ResourceRequirements    `json:"resources,omitempty"`
+	PodSecurityContext     *core.PodSecurityContext     `json:"podSecurityContext,omitempty"`
+	SecurityContext        *core.SecurityContext        `json:"securityContext,omitempty"`
+	Scheduling             *types.SchedulingValues      `json:"scheduling,omitempty"`
+	VerticalScaling        *types.VerticalScalingValues `json:"verticalScaling,omitempty"`
+	Configuration          v1alpha2.Configuration       `json:"configuration,omitempty"`
+	WorkloadClientSettings *ClientSettings              `json:"workloadClientSettings,omitempty"`
+	MCPClientSettings      *ClientSettings              `json:"mcpClientSettings,omitempty"`
+	HPA                    types.HPAValues              `json:"hpa,omitempty"`
+	CAConfigMap            *core.ConfigMapKeySelector   `json:"caConfigMap,omitempty"`
 }
 
 type ReleaseValues struct {
@@ -104,14 +106,13 @@ func (v *Values) Default() error {
 			core.ResourceMemory: memory,
 		}
 	}
-	if v.HPA.MaxReplicas == 0 {
-		v.HPA.MaxReplicas = 1
-	}
-	if v.HPA.AverageCpuUtilization == nil {
-		v.HPA.AverageCpuUtilization = ptr.To(int32(80))
+	v.HPA.Default(1, 1)
+
+	if v.PodSecurityContext == nil {
+		v.PodSecurityContext = types.DefaultPodSecurityContext()
 	}
-	if v.HPA.AverageMemoryUtilization == nil {
-		v.HPA.AverageMemoryUtilization = ptr.To(int32(80))
+	if v.SecurityContext == nil {
+		v.SecurityContext = types.DefaultSecurityContext()
 	}
 
 	return nil
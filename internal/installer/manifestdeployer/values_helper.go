@@ -8,6 +8,7 @@ import (
 	"sigs.k8s.io/yaml"
 
 	"github.com/openmcp-project/service-provider-landscaper/internal/shared/identity"
+	"github.com/openmcp-project/service-provider-landscaper/internal/shared/kubeconfigs"
 )
 
 const (
@@ -21,6 +22,8 @@ type valuesHelper struct {
 
 	configYaml []byte
 	configHash string
+
+	mcpKubeconfig *kubeconfigs.Secret
 }
 
 func newValuesHelper(values *Values) (*valuesHelper, error) {
@@ -39,12 +42,17 @@ func newValuesHelper(values *Values) (*valuesHelper, error) {
 	hash := sha256.Sum256(configYaml)
 	configHash := hex.EncodeToString(hash[:])
 
-	return &valuesHelper{
+	h := &valuesHelper{
 		values:                    values,
 		manifestDeployerComponent: identity.NewComponent(values.Instance, values.Version, componentManifestDeployer),
 		configYaml:                configYaml,
 		configHash:                configHash,
-	}, nil
+	}
+
+	if h.mcpKubeconfig, err = kubeconfigs.NewSecret(h.mcpClusterKubeconfig(), h.mcpKubeconfigSecretName()); err != nil {
+		return nil, err
+	}
+	return h, nil
 }
 
 func newValuesHelperForDelete(values *Values) (*valuesHelper, error) {
@@ -0,0 +1,185 @@
+package rbac
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/openmcp-project/controller-utils/pkg/clusteraccess"
+	"github.com/openmcp-project/controller-utils/pkg/resources"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/tools/clientcmd"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/openmcp-project/service-provider-landscaper/internal/shared/identity"
+)
+
+const (
+	// tokenDuration is the requested validity of the service account tokens of the components.
+	// The tokens are renewed ahead of their expiration, and reach the pods without a restart, see kubeconfigs.Secret.
+	tokenDuration = 24 * time.Hour
+
+	// renewSuffix is the suffix of the keys in the access secret which contain the renewal time of a kubeconfig.
+	renewSuffix = ".renew"
+)
+
+// mcpAccess is the access of a component of a Landscaper instance to the MCP cluster. The component has its own
+// service account in the namespace of the instance, which is bound to a cluster role and optionally to a role in that namespace.
+type mcpAccess struct {
+	component      *identity.Component
+	clusterRules   []rbacv1.PolicyRule
+	namespaceRules []rbacv1.PolicyRule
+}
+
+func (a *mcpAccess) serviceAccountName() string {
+	return a.component.NamespacedDefaultResourceName()
+}
+
+func (a *mcpAccess) roleName() string {
+	return a.component.ClusterScopedDefaultResourceName()
+}
+
+func (a *mcpAccess) subjects() []rbacv1.Subject {
+	return []rbacv1.Subject{{Kind: rbacv1.ServiceAccountKind, Name: a.serviceAccountName(), Namespace: a.component.Namespace()}}
+}
+
+func (a *mcpAccess) install(ctx context.Context, c client.Client) error {
+	labels := a.component.Labels()
+
+	sa := resources.NewServiceAccountMutator(a.serviceAccountName(), a.component.Namespace())
+	sa.MetadataMutator().WithLabels(labels)
+	if err := resources.CreateOrUpdateResource(ctx, c, sa); err != nil {
+		return err
+	}
+
+	clusterRole := resources.NewClusterRoleMutator(a.roleName(), a.clusterRules)
+	clusterRole.MetadataMutator().WithLabels(labels)
+	if err := resources.CreateOrUpdateResource(ctx, c, clusterRole); err != nil {
+		return err
+	}
+
+	clusterRoleBinding := resources.NewClusterRoleBindingMutator(a.roleName(), a.subjects(), resources.NewClusterRoleRef(a.roleName()))
+	clusterRoleBinding.MetadataMutator().WithLabels(labels)
+	if err := resources.CreateOrUpdateResource(ctx, c, clusterRoleBinding); err != nil {
+		return err
+	}
+
+	role := resources.NewRoleMutator(a.roleName(), a.component.Namespace(), a.namespaceRules)
+	roleBinding := resources.NewRoleBindingMutator(a.roleName(), a.component.Namespace(), a.subjects(), resources.NewRoleRef(a.roleName()))
+	if len(a.namespaceRules) == 0 {
+		if err := resources.DeleteResource(ctx, c, roleBinding); err != nil {
+			return err
+		}
+		return resources.DeleteResource(ctx, c, role)
+	}
+
+	role.MetadataMutator().WithLabels(labels)
+	if err := resources.CreateOrUpdateResource(ctx, c, role); err != nil {
+		return err
+	}
+	roleBinding.MetadataMutator().WithLabels(labels)
+	return resources.CreateOrUpdateResource(ctx, c, roleBinding)
+}
+
+func (a *mcpAccess) uninstall(ctx context.Context, c client.Client) error {
+	if err := resources.DeleteResource(ctx, c, resources.NewRoleBindingMutator(a.roleName(), a.component.Namespace(), nil, resources.NewRoleRef(a.roleName()))); err != nil {
+		return err
+	}
+	if err := resources.DeleteResource(ctx, c, resources.NewRoleMutator(a.roleName(), a.component.Namespace(), nil)); err != nil {
+		return err
+	}
+	if err := resources.DeleteResource(ctx, c, resources.NewClusterRoleBindingMutator(a.roleName(), nil, resources.NewClusterRoleRef(a.roleName()))); err != nil {
+		return err
+	}
+	if err := resources.DeleteResource(ctx, c, resources.NewClusterRoleMutator(a.roleName(), nil)); err != nil {
+		return err
+	}
+	return resources.DeleteResource(ctx, c, resources.NewServiceAccountMutator(a.serviceAccountName(), a.component.Namespace()))
+}
+
+// kubeconfig returns a kubeconfig with a token of the service account of the component for the MCP cluster, and its renewal time.
+// Host and certificate authority are taken from the kubeconfig of the provider. A cached kubeconfig is reused
+// until its renewal time, so that the token secrets of the component are not updated on every reconcile.
+func (a *mcpAccess) kubeconfig(ctx context.Context, c client.Client, providerKubeconfig []byte, cache map[string][]byte, now time.Time) ([]byte, time.Time, error) {
+	key := a.serviceAccountName()
+	if renew, err := time.Parse(time.RFC3339, string(cache[key+renewSuffix])); err == nil && now.Before(renew) && len(cache[key]) > 0 {
+		return cache[key], renew, nil
+	}
+
+	restConfig, err := clientcmd.RESTConfigFromKubeConfig(providerKubeconfig)
+	if err != nil {
+		return nil, time.Time{}, fmt.Errorf("failed to read kubeconfig of the mcp cluster: %w", err)
+	}
+
+	sa := &corev1.ServiceAccount{}
+	if err := c.Get(ctx, client.ObjectKey{Name: a.serviceAccountName(), Namespace: a.component.Namespace()}, sa); err != nil {
+		return nil, time.Time{}, fmt.Errorf("failed to get service account %s: %w", a.serviceAccountName(), err)
+	}
+
+	duration := tokenDuration
+	token, err := clusteraccess.CreateTokenForServiceAccount(ctx, c, sa, &duration)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+
+	kubeconfig, err := clusteraccess.CreateTokenKubeconfig(a.serviceAccountName(), restConfig.Host, restConfig.CAData, token.Token)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	return kubeconfig, clusteraccess.ComputeTokenRenewalTime(token.CreationTimestamp, token.ExpirationTimestamp), nil
+}
+
+// readAccessCache returns the data of the secret on the workload cluster in which the kubeconfigs of the components are cached.
+func (h *valuesHelper) readAccessCache(ctx context.Context) (map[string][]byte, error) {
+	secret := &corev1.Secret{}
+	err := h.values.WorkloadCluster.Client().Get(ctx, client.ObjectKey{Name: h.accessSecretName(), Namespace: h.resourceNamespace()}, secret)
+	if apierrors.IsNotFound(err) {
+		return map[string][]byte{}, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to read secret %s: %w", h.accessSecretName(), err)
+	}
+
+	if secret.Data == nil {
+		return map[string][]byte{}, nil
+	}
+	return secret.Data, nil
+}
+
+func (h *valuesHelper) writeAccessCache(ctx context.Context, data map[string][]byte) error {
+	m := resources.NewSecretMutator(h.accessSecretName(), h.resourceNamespace(), data, corev1.SecretTypeOpaque)
+	m.MetadataMutator().WithLabels(h.rbacComponent.Labels())
+	return resources.CreateOrUpdateResource(ctx, h.values.WorkloadCluster.Client(), m)
+}
+
+// accesses returns the accesses of the Landscaper controllers, the webhooks server and the deployers.
+func (h *valuesHelper) accesses() []*mcpAccess {
+	accesses := []*mcpAccess{h.landscaperControllerAccess(), h.webhooksServerAccess()}
+	for _, name := range h.values.Deployers {
+		accesses = append(accesses, h.deployerAccess(name))
+	}
+	return accesses
+}
+
+func (h *valuesHelper) landscaperControllerAccess() *mcpAccess {
+	return &mcpAccess{
+		component:    identity.NewComponent(h.values.Instance, h.values.Version, componentLandscaperController),
+		clusterRules: landscaperControllerRules(),
+	}
+}
+
+func (h *valuesHelper) webhooksServerAccess() *mcpAccess {
+	return &mcpAccess{
+		component:      identity.NewComponent(h.values.Instance, h.values.Version, componentWebhooksServer),
+		clusterRules:   webhooksServerRules(),
+		namespaceRules: webhooksServerNamespaceRules(),
+	}
+}
+
+func (h *valuesHelper) deployerAccess(name string) *mcpAccess {
+	return &mcpAccess{
+		component:    identity.NewComponent(h.values.Instance, h.values.Version, deployerComponentName(name)),
+		clusterRules: deployerRules(),
+	}
+}
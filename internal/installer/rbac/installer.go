@@ -3,6 +3,8 @@ package rbac
 import (
 	"context"
 	_ "embed"
+	"fmt"
+	"time"
 
 	"github.com/openmcp-project/controller-utils/pkg/clusters"
 
@@ -14,11 +16,23 @@ import (
 //go:embed data/test-kubeconfig.yaml
 var testKubeconfig []byte
 
+// Kubeconfigs are the kubeconfigs of the components of a Landscaper instance.
 type Kubeconfigs struct {
-	MCPCluster      []byte
+	// LandscaperController, WebhooksServer and Deployers are the kubeconfigs for the MCP cluster.
+	// Each component has its own service account with the permissions it needs.
+	LandscaperController []byte
+	WebhooksServer       []byte
+	// Deployers maps the names of the deployers to their kubeconfigs.
+	Deployers map[string][]byte
+	// WorkloadCluster is the kubeconfig of the provider for the workload cluster.
 	WorkloadCluster []byte
 }
 
+// Deployer returns the kubeconfig of the deployer with the given name for the MCP cluster.
+func (k *Kubeconfigs) Deployer(name string) string {
+	return string(k.Deployers[name])
+}
+
 type KubeconfigAccessor func(ctx context.Context, cluster *clusters.Cluster) ([]byte, error)
 
 func defaultKubeconfigAccessorImpl(_ context.Context, cluster *clusters.Cluster) ([]byte, error) {
@@ -35,11 +49,18 @@ func SetKubeconfigAccessor(accessor KubeconfigAccessor) {
 	kubeconfigAccessor = accessor
 }
 
+// GetKubeconfigs returns the kubeconfigs of the components of a Landscaper instance. The kubeconfigs for the MCP cluster
+// contain tokens of the service accounts created by InstallLandscaperRBACResources. They are cached in a secret
+// in the namespace of the instance on the workload cluster, and renewed ahead of their expiration.
 func GetKubeconfigs(ctx context.Context, values *Values) (*Kubeconfigs, error) {
-	var err error
-	kubeconfigs := &Kubeconfigs{}
+	valHelper, err := newValuesHelper(values)
+	if err != nil {
+		return nil, err
+	}
 
-	kubeconfigs.MCPCluster, err = kubeconfigAccessor(ctx, values.MCPCluster)
+	kubeconfigs := &Kubeconfigs{Deployers: map[string][]byte{}}
+
+	providerKubeconfig, err := kubeconfigAccessor(ctx, values.MCPCluster)
 	if err != nil {
 		return kubeconfigs, err
 	}
@@ -49,9 +70,70 @@ func GetKubeconfigs(ctx context.Context, values *Values) (*Kubeconfigs, error) {
 		return kubeconfigs, err
 	}
 
+	cache, err := valHelper.readAccessCache(ctx)
+	if err != nil {
+		return kubeconfigs, err
+	}
+
+	updatedCache := map[string][]byte{}
+	now := time.Now()
+	kubeconfig := func(a *mcpAccess) ([]byte, error) {
+		kubeconfig, renew, err := a.kubeconfig(ctx, values.MCPCluster.Client(), providerKubeconfig, cache, now)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get kubeconfig of %s: %w", a.component.Name, err)
+		}
+		updatedCache[a.serviceAccountName()] = kubeconfig
+		updatedCache[a.serviceAccountName()+renewSuffix] = []byte(renew.Format(time.RFC3339))
+		return kubeconfig, nil
+	}
+
+	if kubeconfigs.LandscaperController, err = kubeconfig(valHelper.landscaperControllerAccess()); err != nil {
+		return kubeconfigs, err
+	}
+	if kubeconfigs.WebhooksServer, err = kubeconfig(valHelper.webhooksServerAccess()); err != nil {
+		return kubeconfigs, err
+	}
+	for _, name := range values.Deployers {
+		if kubeconfigs.Deployers[name], err = kubeconfig(valHelper.deployerAccess(name)); err != nil {
+			return kubeconfigs, err
+		}
+	}
+
+	if err = valHelper.writeAccessCache(ctx, updatedCache); err != nil {
+		return kubeconfigs, fmt.Errorf("failed to cache kubeconfigs: %w", err)
+	}
+
 	return kubeconfigs, nil
 }
 
+// TokenRenewalTime returns the earliest renewal time of the cached kubeconfigs of the components for the MCP cluster.
+// It returns the zero time if no kubeconfigs are cached.
+func TokenRenewalTime(ctx context.Context, values *Values) (time.Time, error) {
+	valHelper, err := newValuesHelper(values)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	cache, err := valHelper.readAccessCache(ctx)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	renewalTime := time.Time{}
+	for _, a := range valHelper.accesses() {
+		renew, err := time.Parse(time.RFC3339, string(cache[a.serviceAccountName()+renewSuffix]))
+		if err != nil {
+			continue
+		}
+		if renewalTime.IsZero() || renew.Before(renewalTime) {
+			renewalTime = renew
+		}
+	}
+	return renewalTime, nil
+}
+
+// InstallLandscaperRBACResources creates the namespace of the instance on the MCP cluster, and a service account with
+// the permissions it needs for each component. The access of removed deployers is revoked.
 func InstallLandscaperRBACResources(ctx context.Context, values *Values) error {
 	valHelper, err := newValuesHelper(values)
 	if err != nil {
@@ -60,13 +142,26 @@ func InstallLandscaperRBACResources(ctx context.Context, values *Values) error {
 
 	mcpClient := values.MCPCluster.Client()
 
-	if err = resources.CreateOrUpdateResource(ctx, mcpClient, resources.NewNamespaceMutator(valHelper.resourceNamespace())); err != nil {
+	if err = resources.CreateOrUpdateResource(ctx, mcpClient, values.Instance.NamespaceMutator()); err != nil {
 		return err
 	}
 
+	for _, a := range valHelper.accesses() {
+		if err = a.install(ctx, mcpClient); err != nil {
+			return fmt.Errorf("failed to install access of %s: %w", a.component.Name, err)
+		}
+	}
+
+	for _, name := range values.RemovedDeployers {
+		if err = valHelper.deployerAccess(name).uninstall(ctx, mcpClient); err != nil {
+			return fmt.Errorf("failed to revoke access of deployer %s: %w", name, err)
+		}
+	}
+
 	return nil
 }
 
+// UninstallLandscaperRBACResources revokes the access of all components and deletes the namespace of the instance on the MCP cluster.
 func UninstallLandscaperRBACResources(ctx context.Context, values *Values) error {
 	valHelper, err := newValuesHelper(values)
 	if err != nil {
@@ -75,6 +170,16 @@ func UninstallLandscaperRBACResources(ctx context.Context, values *Values) error
 
 	mcpClient := values.MCPCluster.Client()
 
+	accesses := valHelper.accesses()
+	for _, name := range values.RemovedDeployers {
+		accesses = append(accesses, valHelper.deployerAccess(name))
+	}
+	for _, a := range accesses {
+		if err = a.uninstall(ctx, mcpClient); err != nil {
+			return fmt.Errorf("failed to revoke access of %s: %w", a.component.Name, err)
+		}
+	}
+
 	if err = resources.DeleteResource(ctx, mcpClient, resources.NewNamespaceMutator(valHelper.resourceNamespace())); err != nil {
 		return err
 	}
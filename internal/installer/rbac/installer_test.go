@@ -1,13 +1,18 @@
 package rbac_test
 
 import (
+	"slices"
 	"testing"
+	"time"
 
 	"github.com/openmcp-project/service-provider-landscaper/internal/installer/rbac"
+	"github.com/openmcp-project/service-provider-landscaper/internal/shared/identity"
 
 	"github.com/openmcp-project/controller-utils/pkg/clusters"
 	testutils "github.com/openmcp-project/controller-utils/pkg/testing"
 	clustersv1alpha1 "github.com/openmcp-project/openmcp-operator/api/clusters/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
@@ -56,14 +61,139 @@ var _ = Describe("Landscaper RBAC Installer", func() {
 			WorkloadCluster: workloadCluster,
 		}
 
-		kubeconfigs, err := rbac.GetKubeconfigs(env.Ctx, values)
+		err := rbac.InstallLandscaperRBACResources(env.Ctx, values)
 		Expect(err).ToNot(HaveOccurred())
-		err = rbac.InstallLandscaperRBACResources(env.Ctx, values)
+		kubeconfigs, err := rbac.GetKubeconfigs(env.Ctx, values)
 		Expect(err).ToNot(HaveOccurred())
-		Expect(kubeconfigs.MCPCluster).ToNot(BeEmpty())
+		Expect(kubeconfigs.LandscaperController).ToNot(BeEmpty())
+		Expect(kubeconfigs.WebhooksServer).ToNot(BeEmpty())
 		Expect(kubeconfigs.WorkloadCluster).ToNot(BeEmpty())
 	})
 
+	It("should give each component its own access to the mcp cluster", func() {
+		env := buildTestEnvironment("test-01")
+
+		mcpCluster := clusters.NewTestClusterFromClient("mcp", env.Client())
+		workloadCluster := clusters.NewTestClusterFromClient("workload", env.Client())
+
+		values := &rbac.Values{
+			Instance:        instanceID,
+			Version:         "v0.127.0",
+			MCPCluster:      mcpCluster,
+			WorkloadCluster: workloadCluster,
+			Deployers:       []string{"helm", "manifest"},
+		}
+
+		Expect(rbac.InstallLandscaperRBACResources(env.Ctx, values)).To(Succeed())
+
+		namespace := identity.Instance(instanceID).Namespace()
+		for _, name := range []string{"landscaper-controller", "landscaper-webhooks-server", "helm-deployer", "manifest-deployer"} {
+			Expect(env.Client().Get(env.Ctx, client.ObjectKey{Name: name, Namespace: namespace}, &corev1.ServiceAccount{})).To(Succeed())
+
+			binding := &rbacv1.ClusterRoleBinding{}
+			Expect(env.Client().Get(env.Ctx, client.ObjectKey{Name: "landscaper:" + instanceID + ":" + name}, binding)).To(Succeed())
+			Expect(binding.Subjects).To(ConsistOf(rbacv1.Subject{Kind: rbacv1.ServiceAccountKind, Name: name, Namespace: namespace}))
+		}
+
+		// deployers cannot modify custom resource definitions
+		deployerRole := &rbacv1.ClusterRole{}
+		Expect(env.Client().Get(env.Ctx, client.ObjectKey{Name: "landscaper:" + instanceID + ":helm-deployer"}, deployerRole)).To(Succeed())
+		for _, rule := range deployerRole.Rules {
+			Expect(rule.APIGroups).ToNot(ContainElement("apiextensions.k8s.io"))
+			Expect(rule.Resources).ToNot(ContainElement("*"))
+		}
+
+		// the webhooks server can only modify its own validating webhook configuration
+		webhooksRole := &rbacv1.ClusterRole{}
+		Expect(env.Client().Get(env.Ctx, client.ObjectKey{Name: "landscaper:" + instanceID + ":landscaper-webhooks-server"}, webhooksRole)).To(Succeed())
+		var webhookConfigurationRules []rbacv1.PolicyRule
+		for _, rule := range webhooksRole.Rules {
+			if slices.Contains(rule.Resources, "validatingwebhookconfigurations") {
+				webhookConfigurationRules = append(webhookConfigurationRules, rule)
+			}
+		}
+		Expect(webhookConfigurationRules).To(ConsistOf(
+			rbacv1.PolicyRule{
+				APIGroups: []string{"admissionregistration.k8s.io"},
+				Resources: []string{"validatingwebhookconfigurations"},
+				Verbs:     []string{"create"},
+			},
+			rbacv1.PolicyRule{
+				APIGroups:     []string{"admissionregistration.k8s.io"},
+				Resources:     []string{"validatingwebhookconfigurations"},
+				ResourceNames: []string{"landscaper-validation-webhook"},
+				Verbs:         []string{"get", "update", "patch", "delete"},
+			},
+		))
+
+		// the webhooks server stores its certificates in the namespace of the instance
+		Expect(env.Client().Get(env.Ctx, client.ObjectKey{Name: "landscaper:" + instanceID + ":landscaper-webhooks-server", Namespace: namespace}, &rbacv1.RoleBinding{})).To(Succeed())
+
+		kubeconfigs, err := rbac.GetKubeconfigs(env.Ctx, values)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(kubeconfigs.Deployers).To(HaveKey("helm"))
+		Expect(kubeconfigs.Deployers).To(HaveKey("manifest"))
+		Expect(string(kubeconfigs.Deployers["helm"])).To(ContainSubstring("server: https://api.test.local:6443"))
+		Expect(string(kubeconfigs.Deployers["helm"])).To(ContainSubstring("helm-deployer"))
+
+		// the kubeconfigs are cached until their renewal time
+		cache := &corev1.Secret{}
+		Expect(env.Client().Get(env.Ctx, client.ObjectKey{Name: "landscaper-rbac-mcp-access", Namespace: namespace}, cache)).To(Succeed())
+		Expect(cache.Data).To(HaveKeyWithValue("helm-deployer", kubeconfigs.Deployers["helm"]))
+		cache.Data["helm-deployer"] = []byte("cached")
+		Expect(env.Client().Update(env.Ctx, cache)).To(Succeed())
+
+		kubeconfigs, err = rbac.GetKubeconfigs(env.Ctx, values)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(kubeconfigs.Deployer("helm")).To(Equal("cached"))
+
+		// the access of a removed deployer is revoked
+		values.Deployers = []string{"manifest"}
+		values.RemovedDeployers = []string{"helm"}
+		Expect(rbac.InstallLandscaperRBACResources(env.Ctx, values)).To(Succeed())
+		Expect(env.Client().Get(env.Ctx, client.ObjectKey{Name: "helm-deployer", Namespace: namespace}, &corev1.ServiceAccount{})).ToNot(Succeed())
+		Expect(env.Client().Get(env.Ctx, client.ObjectKey{Name: "landscaper:" + instanceID + ":helm-deployer"}, &rbacv1.ClusterRole{})).ToNot(Succeed())
+
+		kubeconfigs, err = rbac.GetKubeconfigs(env.Ctx, values)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(kubeconfigs.Deployers).ToNot(HaveKey("helm"))
+	})
+
+	It("should return the earliest renewal time of the cached kubeconfigs", func() {
+		env := buildTestEnvironment("test-01")
+
+		mcpCluster := clusters.NewTestClusterFromClient("mcp", env.Client())
+		workloadCluster := clusters.NewTestClusterFromClient("workload", env.Client())
+
+		values := &rbac.Values{
+			Instance:        instanceID,
+			Version:         "v0.127.0",
+			MCPCluster:      mcpCluster,
+			WorkloadCluster: workloadCluster,
+			Deployers:       []string{"helm"},
+		}
+
+		renewalTime, err := rbac.TokenRenewalTime(env.Ctx, values)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(renewalTime.IsZero()).To(BeTrue())
+
+		Expect(rbac.InstallLandscaperRBACResources(env.Ctx, values)).To(Succeed())
+		_, err = rbac.GetKubeconfigs(env.Ctx, values)
+		Expect(err).ToNot(HaveOccurred())
+
+		now := time.Now().Truncate(time.Second)
+		cache := &corev1.Secret{}
+		Expect(env.Client().Get(env.Ctx, client.ObjectKey{Name: "landscaper-rbac-mcp-access", Namespace: identity.Instance(instanceID).Namespace()}, cache)).To(Succeed())
+		cache.Data["landscaper-controller.renew"] = []byte(now.Add(3 * time.Hour).Format(time.RFC3339))
+		cache.Data["landscaper-webhooks-server.renew"] = []byte(now.Add(2 * time.Hour).Format(time.RFC3339))
+		cache.Data["helm-deployer.renew"] = []byte(now.Add(1 * time.Hour).Format(time.RFC3339))
+		Expect(env.Client().Update(env.Ctx, cache)).To(Succeed())
+
+		renewalTime, err = rbac.TokenRenewalTime(env.Ctx, values)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(renewalTime).To(BeTemporally("==", now.Add(1*time.Hour)))
+	})
+
 	It("should uninstall the landscaper rbac resources", func() {
 		env := buildTestEnvironment("test-01")
 
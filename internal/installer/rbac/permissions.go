@@ -0,0 +1,119 @@
+package rbac
+
+import (
+	rbacv1 "k8s.io/api/rbac/v1"
+)
+
+var (
+	defaultVerbs = []string{"get", "list", "watch", "create", "update", "patch", "delete"}
+	readVerbs    = []string{"get", "list", "watch"}
+	eventVerbs   = []string{"create", "update", "patch"}
+)
+
+// landscaperControllerRules are the permissions of the Landscaper controllers on the MCP cluster.
+// The central controller deploys the Landscaper CRDs, the main controller processes Installations and Executions.
+func landscaperControllerRules() []rbacv1.PolicyRule {
+	return []rbacv1.PolicyRule{
+		{
+			APIGroups: []string{"apiextensions.k8s.io"},
+			Resources: []string{"customresourcedefinitions"},
+			Verbs:     defaultVerbs,
+		},
+		{
+			APIGroups: []string{"landscaper.gardener.cloud"},
+			Resources: []string{"*"},
+			Verbs:     defaultVerbs,
+		},
+		{
+			APIGroups: []string{""},
+			Resources: []string{"secrets", "configmaps", "serviceaccounts", "namespaces"},
+			Verbs:     defaultVerbs,
+		},
+		{
+			APIGroups: []string{""},
+			Resources: []string{"serviceaccounts/token"},
+			Verbs:     []string{"create"},
+		},
+		{
+			APIGroups: []string{""},
+			Resources: []string{"events"},
+			Verbs:     eventVerbs,
+		},
+	}
+}
+
+// webhookConfigurationName is the name of the validating webhook configuration in which the webhooks server registers itself.
+const webhookConfigurationName = "landscaper-validation-webhook"
+
+// webhooksServerRules are the permissions of the webhooks server on the MCP cluster.
+// It validates the Landscaper resources and registers itself in a validating webhook configuration.
+func webhooksServerRules() []rbacv1.PolicyRule {
+	return []rbacv1.PolicyRule{
+		{
+			APIGroups: []string{"landscaper.gardener.cloud"},
+			Resources: []string{"*"},
+			Verbs:     readVerbs,
+		},
+		{
+			// the name of a new object is not known to the authorization, so that create cannot be restricted to it
+			APIGroups: []string{"admissionregistration.k8s.io"},
+			Resources: []string{"validatingwebhookconfigurations"},
+			Verbs:     []string{"create"},
+		},
+		{
+			APIGroups:     []string{"admissionregistration.k8s.io"},
+			Resources:     []string{"validatingwebhookconfigurations"},
+			ResourceNames: []string{webhookConfigurationName},
+			Verbs:         []string{"get", "update", "patch", "delete"},
+		},
+	}
+}
+
+// webhooksServerNamespaceRules are the permissions of the webhooks server in the namespace of the instance on the MCP cluster,
+// where it stores its certificates.
+func webhooksServerNamespaceRules() []rbacv1.PolicyRule {
+	return []rbacv1.PolicyRule{
+		{
+			APIGroups: []string{""},
+			Resources: []string{"secrets"},
+			Verbs:     defaultVerbs,
+		},
+	}
+}
+
+// deployerRules are the permissions of a deployer on the MCP cluster. A deployer processes the DeployItems of its type,
+// reads their Targets and Contexts, and writes their exports into secrets.
+func deployerRules() []rbacv1.PolicyRule {
+	return []rbacv1.PolicyRule{
+		{
+			APIGroups: []string{"landscaper.gardener.cloud"},
+			Resources: []string{"deployitems", "deployitems/status"},
+			Verbs:     []string{"get", "list", "watch", "update", "patch"},
+		},
+		{
+			APIGroups: []string{"landscaper.gardener.cloud"},
+			Resources: []string{"targets", "contexts"},
+			Verbs:     readVerbs,
+		},
+		{
+			APIGroups: []string{"landscaper.gardener.cloud"},
+			Resources: []string{"syncobjects", "criticalproblems"},
+			Verbs:     defaultVerbs,
+		},
+		{
+			APIGroups: []string{""},
+			Resources: []string{"secrets"},
+			Verbs:     defaultVerbs,
+		},
+		{
+			APIGroups: []string{""},
+			Resources: []string{"configmaps"},
+			Verbs:     readVerbs,
+		},
+		{
+			APIGroups: []string{""},
+			Resources: []string{"events"},
+			Verbs:     eventVerbs,
+		},
+	}
+}
@@ -11,6 +11,11 @@ type Values struct {
 	Version         string            `json:"version,omitempty"`
 	MCPCluster      *clusters.Cluster
 	WorkloadCluster *clusters.Cluster `json:"workloadCluster,omitempty"`
+	// Deployers are the names of the installed deployers, including the helm and manifest deployer.
+	// Each deployer gets its own access to the MCP cluster.
+	Deployers []string `json:"deployers,omitempty"`
+	// RemovedDeployers are the names of uninstalled deployers, whose access to the MCP cluster is revoked.
+	RemovedDeployers []string `json:"removedDeployers,omitempty"`
 }
 
 type ServiceAccountValues struct {
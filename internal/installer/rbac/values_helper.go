@@ -7,7 +7,9 @@ import (
 )
 
 const (
-	componentLandscaperRBAC = "landscaper-rbac"
+	componentLandscaperRBAC       = "landscaper-rbac"
+	componentLandscaperController = "landscaper-controller"
+	componentWebhooksServer       = "landscaper-webhooks-server"
 )
 
 type valuesHelper struct {
@@ -29,3 +31,13 @@ func newValuesHelper(values *Values) (*valuesHelper, error) {
 func (h *valuesHelper) resourceNamespace() string {
 	return h.values.Instance.Namespace()
 }
+
+// accessSecretName is the name of the secret on the workload cluster in which the kubeconfigs of the components are cached.
+func (h *valuesHelper) accessSecretName() string {
+	return h.rbacComponent.NamespacedResourceName("mcp-access")
+}
+
+// deployerComponentName returns the name of the component of the deployer with the given name.
+func deployerComponentName(name string) string {
+	return fmt.Sprintf("%s-deployer", name)
+}
@@ -10,6 +10,9 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	api "github.com/openmcp-project/service-provider-landscaper/api/v1alpha2"
+	"github.com/openmcp-project/service-provider-landscaper/internal/shared/sources"
 )
 
 const (
@@ -33,35 +36,27 @@ func SSLCertDirEnvValue() string {
 
 var ErrNilSourceConfigMapRef = errors.New("caBundleRef must not be nil")
 
-// ConfigMapSync is a helper to sync configmaps from the platform cluster to the workload cluster.
-// It copies a selected key from the platform cluster namespace to the workload cluster namespace and
-// renames the copied configmap to avoid name clashes between components.
+// ConfigMapSync is a helper to sync configmaps from their sources to the workload cluster.
+// It copies the referenced configmap to the workload cluster namespace.
 type ConfigMapSync struct {
-	PlatformCluster          *clusters.Cluster
-	PlatformClusterNamespace string
+	Sources                  *sources.Resolver
 	WorkloadCluster          *clusters.Cluster
 	WorkloadClusterNamespace string
 }
 
-func (s *ConfigMapSync) CreateOrUpdate(ctx context.Context, caBundleRef *corev1.ConfigMapKeySelector) (*corev1.ConfigMapKeySelector, error) {
+func (s *ConfigMapSync) CreateOrUpdate(ctx context.Context, caBundleRef *api.SourceKeyReference) (*corev1.ConfigMapKeySelector, error) {
 	if caBundleRef == nil {
 		return nil, ErrNilSourceConfigMapRef
 	}
 
-	sourceCM := &corev1.ConfigMap{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      caBundleRef.Name,
-			Namespace: s.PlatformClusterNamespace,
-		},
-	}
-
-	if err := s.PlatformCluster.Client().Get(ctx, client.ObjectKeyFromObject(sourceCM), sourceCM); err != nil {
+	data, err := s.Sources.ConfigMap(ctx, caBundleRef.SourceReference)
+	if err != nil {
 		return nil, err
 	}
 
 	cmName := caBundleRef.Name
 
-	if err := resources.CreateOrUpdateResource(ctx, s.WorkloadCluster.Client(), newCAConfigMapMutator(cmName, s.WorkloadClusterNamespace, sourceCM.Data)); err != nil {
+	if err := resources.CreateOrUpdateResource(ctx, s.WorkloadCluster.Client(), newCAConfigMapMutator(cmName, s.WorkloadClusterNamespace, data)); err != nil {
 		return nil, err
 	}
 
@@ -73,7 +68,7 @@ func (s *ConfigMapSync) CreateOrUpdate(ctx context.Context, caBundleRef *corev1.
 	}, nil
 }
 
-func (s *ConfigMapSync) Delete(ctx context.Context, caBundleRef *corev1.ConfigMapKeySelector) error {
+func (s *ConfigMapSync) Delete(ctx context.Context, caBundleRef *api.SourceKeyReference) error {
 	if caBundleRef == nil {
 		return ErrNilSourceConfigMapRef
 	}
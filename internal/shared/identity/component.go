@@ -2,6 +2,7 @@ package identity
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/openmcp-project/controller-utils/pkg/controller"
 	"golang.org/x/exp/maps"
@@ -111,6 +112,33 @@ func (c *Component) ClusterScopedResourceName(suffix string) string {
 	return fmt.Sprintf("%s:%s:%s:%s", applicationLandscaper, c.Instance, c.Name, suffix)
 }
 
+// InstanceFromClusterScopedResourceName returns the instance to which a cluster-scoped resource with the given name belongs.
+// The second return value is false, if the name has not the form "landscaper:<instance>:<component>[:<suffix>]".
+func InstanceFromClusterScopedResourceName(name string) (Instance, bool) {
+	parts := strings.Split(name, ":")
+	if len(parts) < 3 || parts[0] != applicationLandscaper || parts[1] == "" {
+		return "", false
+	}
+	return Instance(parts[1]), true
+}
+
+// ManagedByLabels returns the labels that all resources managed by the landscaper provider have in common.
+func ManagedByLabels() map[string]string {
+	return map[string]string{
+		labelManagedBy: labelValueManagedBy,
+	}
+}
+
+// InstanceFromLabels returns the instance to which a resource with the given labels belongs.
+// The second return value is false, if the labels do not identify an instance.
+func InstanceFromLabels(labels map[string]string) (Instance, bool) {
+	id, found := strings.CutPrefix(labels[labelAppInstance], applicationLandscaper+"-")
+	if !found || id == "" {
+		return "", false
+	}
+	return Instance(id), true
+}
+
 func (c *Component) ImagePullSecretName(sourceSecretName string) string {
 	return c.NamespacedResourceName(fmt.Sprintf("imgpull-%s", controller.NameHashSHAKE128Base32(sourceSecretName)))
 }
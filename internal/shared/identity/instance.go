@@ -1,11 +1,48 @@
 package identity
 
-import "fmt"
+import (
+	"fmt"
+	"maps"
+	"strings"
+
+	"github.com/openmcp-project/controller-utils/pkg/resources"
+	corev1 "k8s.io/api/core/v1"
+)
+
+const namespacePrefix = "ls-system-"
 
 // Instance identifies a landscaper installation for an update or delete operation, e.g. "test0001-abcdefgh".
 type Instance string
 
 // Namespace is the namespace on the landscaper host resp. resource cluster where objects will be installed.
 func (i Instance) Namespace() string {
-	return fmt.Sprintf("ls-system-%s", i)
+	return fmt.Sprintf("%s%s", namespacePrefix, i)
+}
+
+// Labels returns the labels of the resources of the instance which belong to no component, e.g. its namespaces.
+func (i Instance) Labels() map[string]string {
+	labels := map[string]string{
+		labelAppName:     applicationLandscaper,
+		labelAppInstance: fmt.Sprintf("%s-%s", applicationLandscaper, i),
+	}
+	maps.Copy(labels, ManagedByLabels())
+	return labels
+}
+
+// NamespaceMutator returns the mutator of the namespace of the instance. The namespace is labeled as managed by the provider,
+// so that it can be told apart from namespaces of others with a similar name.
+func (i Instance) NamespaceMutator() resources.Mutator[*corev1.Namespace] {
+	m := resources.NewNamespaceMutator(i.Namespace())
+	m.MetadataMutator().WithLabels(i.Labels())
+	return m
+}
+
+// InstanceFromNamespace returns the instance to which the given namespace belongs.
+// The second return value is false, if the namespace is not the namespace of an instance.
+func InstanceFromNamespace(namespace string) (Instance, bool) {
+	id, found := strings.CutPrefix(namespace, namespacePrefix)
+	if !found || id == "" {
+		return "", false
+	}
+	return Instance(id), true
 }
@@ -2,48 +2,41 @@ package imagepullsecrets
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/openmcp-project/controller-utils/pkg/clusters"
 	"github.com/openmcp-project/controller-utils/pkg/resources"
-	"github.com/openmcp-project/openmcp-operator/api/common"
 	corev1 "k8s.io/api/core/v1"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	api "github.com/openmcp-project/service-provider-landscaper/api/v1alpha2"
 	"github.com/openmcp-project/service-provider-landscaper/internal/shared/identity"
+	"github.com/openmcp-project/service-provider-landscaper/internal/shared/sources"
 )
 
-// SecretSync is a helper to sync image pull secrets from the platform cluster to the workload cluster.
-// It copies the secrets from the platform cluster namespace to the workload cluster namespace and
-// renames them to include the component name as prefix to avoid name clashes. Each copied secret name is guaranteed to be unique
-// per component, even if the same image pull secret is used in multiple components.
+// SecretSync is a helper to sync image pull secrets from their sources to the workload cluster.
+// It copies the secrets to the workload cluster namespace and renames them to include the component name as prefix
+// to avoid name clashes. Each copied secret name is guaranteed to be unique per component, even if the same image pull secret
+// is used in multiple components.
 type SecretSync struct {
-	PlatformCluster          *clusters.Cluster
-	PlatformClusterNamespace string
+	Sources                  *sources.Resolver
 	WorkloadCluster          *clusters.Cluster
 	WorkloadClusterNamespace string
 }
 
-// CreateOrUpdate copies the image pull secrets from the platform cluster to the workload cluster.
+// CreateOrUpdate copies the image pull secrets from their sources to the workload cluster.
 // It returns a list of LocalObjectReference that can be used in the PodSpec of the component.
-func (s *SecretSync) CreateOrUpdate(ctx context.Context, c *identity.Component, imagePullSecrets []common.LocalObjectReference) ([]corev1.LocalObjectReference, error) {
+func (s *SecretSync) CreateOrUpdate(ctx context.Context, c *identity.Component, imagePullSecrets []api.SourceReference) ([]corev1.LocalObjectReference, error) {
 	imagePullSecretRefs := make([]corev1.LocalObjectReference, 0, len(imagePullSecrets))
 
 	for _, ips := range imagePullSecrets {
-		sourceSecret := &corev1.Secret{
-			ObjectMeta: metav1.ObjectMeta{
-				Name:      ips.Name,
-				Namespace: s.PlatformClusterNamespace,
-			},
+		data, err := s.Sources.Secret(ctx, ips)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read image pull secret %s: %w", ips.Name, err)
 		}
 
-		if err := s.PlatformCluster.Client().Get(ctx, client.ObjectKeyFromObject(sourceSecret), sourceSecret); err != nil {
-			return nil, err
-		}
+		imagePullSecretName := c.ImagePullSecretName(sources.Key(ips))
 
-		imagePullSecretName := c.ImagePullSecretName(ips.Name)
-
-		if err := resources.CreateOrUpdateResource(ctx, s.WorkloadCluster.Client(), newImagePullSecretMutator(imagePullSecretName, s.WorkloadClusterNamespace, sourceSecret.Data, c)); err != nil {
+		if err := resources.CreateOrUpdateResource(ctx, s.WorkloadCluster.Client(), newImagePullSecretMutator(imagePullSecretName, s.WorkloadClusterNamespace, data, c)); err != nil {
 			return nil, err
 		}
 
@@ -55,21 +48,10 @@ func (s *SecretSync) CreateOrUpdate(ctx context.Context, c *identity.Component,
 	return imagePullSecretRefs, nil
 }
 
-// Delete removes the image pull secrets from the workload cluster that were copied from the platform cluster.
-func (s *SecretSync) Delete(ctx context.Context, c *identity.Component, imagePullSecrets []common.LocalObjectReference) error {
+// Delete removes the image pull secrets from the workload cluster that were copied from their sources.
+func (s *SecretSync) Delete(ctx context.Context, c *identity.Component, imagePullSecrets []api.SourceReference) error {
 	for _, ips := range imagePullSecrets {
-		sourceSecret := &corev1.Secret{
-			ObjectMeta: metav1.ObjectMeta{
-				Name:      ips.Name,
-				Namespace: s.PlatformClusterNamespace,
-			},
-		}
-
-		if err := s.PlatformCluster.Client().Get(ctx, client.ObjectKeyFromObject(sourceSecret), sourceSecret); err != nil {
-			return err
-		}
-
-		if err := resources.DeleteResource(ctx, s.WorkloadCluster.Client(), newImagePullSecretMutator(ips.Name, s.WorkloadClusterNamespace, sourceSecret.Data, c)); err != nil {
+		if err := resources.DeleteResource(ctx, s.WorkloadCluster.Client(), newImagePullSecretMutator(c.ImagePullSecretName(sources.Key(ips)), s.WorkloadClusterNamespace, nil, c)); err != nil {
 			return err
 		}
 	}
@@ -0,0 +1,92 @@
+package kubeconfigs
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"path"
+
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+const (
+	// KubeconfigKey is the key of the kubeconfig in the kubeconfig secret of a component.
+	KubeconfigKey = "kubeconfig"
+	// TokenKey is the key of the token in the kubeconfig secret of a component.
+	TokenKey = "token"
+
+	mountRoot = "/app/ls"
+)
+
+// MountPath returns the directory into which the kubeconfig secret with the given name is mounted in the containers.
+func MountPath(secretName string) string {
+	return path.Join(mountRoot, secretName)
+}
+
+// KubeconfigPath returns the path of the kubeconfig in the mounted kubeconfig secret with the given name.
+func KubeconfigPath(secretName string) string {
+	return path.Join(MountPath(secretName), KubeconfigKey)
+}
+
+// Secret is the content of the kubeconfig secret of a component.
+//
+// The token of the kubeconfig is stored separately and referenced as token file, which client-go re-reads periodically.
+// As the kubelet updates mounted secrets in place, a rotated token reaches the running pods without a restart.
+// Only changes of the kubeconfig itself, e.g. of the host or certificate authority, require new pods.
+type Secret struct {
+	kubeconfig []byte
+	token      []byte
+}
+
+// NewSecret returns the content of the kubeconfig secret with the given name. Kubeconfigs whose current user
+// does not authenticate with a token are kept unchanged.
+func NewSecret(kubeconfig []byte, secretName string) (*Secret, error) {
+	s := &Secret{kubeconfig: kubeconfig}
+	if len(kubeconfig) == 0 {
+		return s, nil
+	}
+
+	config, err := clientcmd.Load(kubeconfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read kubeconfig for secret %s: %w", secretName, err)
+	}
+
+	currentContext, ok := config.Contexts[config.CurrentContext]
+	if !ok {
+		return s, nil
+	}
+	authInfo, ok := config.AuthInfos[currentContext.AuthInfo]
+	if !ok || authInfo.Token == "" {
+		return s, nil
+	}
+
+	s.token = []byte(authInfo.Token)
+	authInfo.Token = ""
+	authInfo.TokenFile = path.Join(MountPath(secretName), TokenKey)
+	if s.kubeconfig, err = clientcmd.Write(*config); err != nil {
+		return nil, fmt.Errorf("failed to write kubeconfig for secret %s: %w", secretName, err)
+	}
+	return s, nil
+}
+
+// Data returns the data of the kubeconfig secret.
+func (s *Secret) Data() map[string][]byte {
+	if s == nil {
+		return nil
+	}
+	data := map[string][]byte{KubeconfigKey: s.kubeconfig}
+	if len(s.token) > 0 {
+		data[TokenKey] = s.token
+	}
+	return data
+}
+
+// Hash returns a hash of the kubeconfig without the token. It is used as checksum annotation of the pods,
+// so that they are only replaced if the kubeconfig changes, but not if the token is rotated.
+func (s *Secret) Hash() string {
+	if s == nil {
+		return ""
+	}
+	hash := sha256.Sum256(s.kubeconfig)
+	return hex.EncodeToString(hash[:])
+}
@@ -0,0 +1,69 @@
+package sources
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// FileSource reads secrets and config maps from a directory, which contains a subdirectory for each secret or config map
+// with a file for each key. This is the layout of mounted secret volumes, so that an external secret store can be connected
+// by mounting its secrets into the provider, for example with the secrets store CSI driver. It also serves as local
+// secret store in tests.
+type FileSource struct {
+	Dir string
+}
+
+var _ Source = &FileSource{}
+
+func (s *FileSource) Secret(_ context.Context, name string) (map[string][]byte, error) {
+	return s.read(name)
+}
+
+func (s *FileSource) ConfigMap(_ context.Context, name string) (map[string]string, error) {
+	files, err := s.read(name)
+	if err != nil {
+		return nil, err
+	}
+	data := make(map[string]string, len(files))
+	for key, value := range files {
+		data[key] = string(value)
+	}
+	return data, nil
+}
+
+// read returns the content of the files in the subdirectory with the given name. Entries starting with ".." are skipped,
+// as mounted volumes contain such directories, to which the files of the keys are links. Keys like .dockerconfigjson
+// start with a single dot and are read.
+func (s *FileSource) read(name string) (map[string][]byte, error) {
+	if name == "" || name != filepath.Base(name) || strings.HasPrefix(name, ".") {
+		return nil, fmt.Errorf("invalid name %q in external secret store", name)
+	}
+
+	dir := filepath.Join(s.Dir, name)
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s from external secret store: %w", name, err)
+	}
+
+	data := map[string][]byte{}
+	for _, entry := range entries {
+		if strings.HasPrefix(entry.Name(), "..") {
+			continue
+		}
+		path := filepath.Join(dir, entry.Name())
+		info, err := os.Stat(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s from external secret store: %w", name, err)
+		}
+		if info.IsDir() {
+			continue
+		}
+		if data[entry.Name()], err = os.ReadFile(path); err != nil {
+			return nil, fmt.Errorf("failed to read %s from external secret store: %w", name, err)
+		}
+	}
+	return data, nil
+}
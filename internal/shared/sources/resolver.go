@@ -0,0 +1,79 @@
+package sources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/openmcp-project/controller-utils/pkg/clusters"
+
+	api "github.com/openmcp-project/service-provider-landscaper/api/v1alpha2"
+)
+
+// Resolver resolves the references to secrets and config maps of a Landscaper instance to their sources.
+type Resolver struct {
+	// PlatformCluster and PlatformClusterNamespace are the platform cluster and the namespace of the service provider,
+	// which is the default namespace of references with source Platform.
+	PlatformCluster          *clusters.Cluster
+	PlatformClusterNamespace string
+	// OnboardingCluster and OnboardingClusterNamespace are the onboarding cluster and the namespace of the Landscaper resource,
+	// in which the tenant provides the secrets and config maps of references with source Onboarding.
+	OnboardingCluster          *clusters.Cluster
+	OnboardingClusterNamespace string
+	// External is the external secret store of the service provider. It is nil if none is configured.
+	External Source
+}
+
+// Source returns the source of the given reference.
+func (r *Resolver) Source(ref api.SourceReference) (Source, error) {
+	if ref.Namespace != "" && ref.GetSource() != api.SourcePlatform {
+		return nil, fmt.Errorf("reference %s with source %s must not specify a namespace", ref.Name, ref.GetSource())
+	}
+
+	switch ref.GetSource() {
+	case api.SourcePlatform:
+		namespace := ref.Namespace
+		if namespace == "" {
+			namespace = r.PlatformClusterNamespace
+		}
+		return &ClusterSource{Cluster: r.PlatformCluster, Namespace: namespace}, nil
+	case api.SourceOnboarding:
+		if r.OnboardingCluster == nil {
+			return nil, fmt.Errorf("reference %s with source %s cannot be resolved without onboarding cluster", ref.Name, ref.GetSource())
+		}
+		return &ClusterSource{Cluster: r.OnboardingCluster, Namespace: r.OnboardingClusterNamespace}, nil
+	case api.SourceExternal:
+		if r.External == nil {
+			return nil, fmt.Errorf("reference %s with source %s cannot be resolved, as no external secret store is configured", ref.Name, ref.GetSource())
+		}
+		return r.External, nil
+	default:
+		return nil, fmt.Errorf("reference %s has unknown source %s", ref.Name, ref.GetSource())
+	}
+}
+
+// Secret returns the data of the referenced secret.
+func (r *Resolver) Secret(ctx context.Context, ref api.SourceReference) (map[string][]byte, error) {
+	source, err := r.Source(ref)
+	if err != nil {
+		return nil, err
+	}
+	return source.Secret(ctx, ref.Name)
+}
+
+// ConfigMap returns the data of the referenced config map.
+func (r *Resolver) ConfigMap(ctx context.Context, ref api.SourceReference) (map[string]string, error) {
+	source, err := r.Source(ref)
+	if err != nil {
+		return nil, err
+	}
+	return source.ConfigMap(ctx, ref.Name)
+}
+
+// Key returns a key which identifies the referenced secret or config map among all sources. References of the
+// namespace of the service provider are identified by their name, so that the key does not change by the defaulting.
+func Key(ref api.SourceReference) string {
+	if ref.GetSource() == api.SourcePlatform && ref.Namespace == "" {
+		return ref.Name
+	}
+	return fmt.Sprintf("%s/%s/%s", ref.GetSource(), ref.Namespace, ref.Name)
+}
@@ -0,0 +1,45 @@
+package sources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/openmcp-project/controller-utils/pkg/clusters"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Source provides the data of secrets and config maps, which the provider syncs to the workload cluster,
+// for example image pull secrets, registry credentials and CA bundles.
+//
+// External secret stores are integrated by implementing this interface.
+type Source interface {
+	// Secret returns the data of the secret with the given name.
+	Secret(ctx context.Context, name string) (map[string][]byte, error)
+	// ConfigMap returns the data of the config map with the given name.
+	ConfigMap(ctx context.Context, name string) (map[string]string, error)
+}
+
+// ClusterSource reads secrets and config maps from a namespace of a cluster.
+type ClusterSource struct {
+	Cluster   *clusters.Cluster
+	Namespace string
+}
+
+var _ Source = &ClusterSource{}
+
+func (s *ClusterSource) Secret(ctx context.Context, name string) (map[string][]byte, error) {
+	secret := &corev1.Secret{}
+	if err := s.Cluster.Client().Get(ctx, client.ObjectKey{Name: name, Namespace: s.Namespace}, secret); err != nil {
+		return nil, fmt.Errorf("failed to get secret %s/%s: %w", s.Namespace, name, err)
+	}
+	return secret.Data, nil
+}
+
+func (s *ClusterSource) ConfigMap(ctx context.Context, name string) (map[string]string, error) {
+	configMap := &corev1.ConfigMap{}
+	if err := s.Cluster.Client().Get(ctx, client.ObjectKey{Name: name, Namespace: s.Namespace}, configMap); err != nil {
+		return nil, fmt.Errorf("failed to get config map %s/%s: %w", s.Namespace, name, err)
+	}
+	return configMap.Data, nil
+}
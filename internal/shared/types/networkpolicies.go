@@ -0,0 +1,16 @@
+package types
+
+import (
+	networkingv1 "k8s.io/api/networking/v1"
+)
+
+// NetworkPolicyValues configure the network policies which isolate the namespace of a Landscaper instance.
+// All traffic of the pods in the namespace is denied, except for the allowed rules.
+type NetworkPolicyValues struct {
+	// Gateway are the peers which may reach the webhooks server on its service port.
+	Gateway []networkingv1.NetworkPolicyPeer `json:"gateway,omitempty"`
+	// Egress are the allowed egress rules of all pods in the namespace.
+	Egress []networkingv1.NetworkPolicyEgressRule `json:"egress,omitempty"`
+	// Ingress are the allowed ingress rules of all pods in the namespace.
+	Ingress []networkingv1.NetworkPolicyIngressRule `json:"ingress,omitempty"`
+}
@@ -0,0 +1,63 @@
+package types
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+)
+
+const (
+	// TmpVolumeName is the name of the writable volume which is mounted at TmpPath into all containers,
+	// because their root filesystem is read-only by default.
+	TmpVolumeName = "tmp"
+	TmpPath       = "/tmp"
+
+	// DefaultFSGroup is the group of the nonroot user of the images. Volumes which are not writable for all users,
+	// e.g. the persistent cache of the main Landscaper controller, are owned by this group.
+	DefaultFSGroup int64 = 65532
+)
+
+// DefaultPodSecurityContext returns the pod security context of the restricted Pod Security Standard.
+func DefaultPodSecurityContext() *corev1.PodSecurityContext {
+	return &corev1.PodSecurityContext{
+		RunAsNonRoot:        ptr.To(true),
+		FSGroup:             ptr.To(DefaultFSGroup),
+		FSGroupChangePolicy: ptr.To(corev1.FSGroupChangeOnRootMismatch),
+		SeccompProfile: &corev1.SeccompProfile{
+			Type: corev1.SeccompProfileTypeRuntimeDefault,
+		},
+	}
+}
+
+// DefaultSecurityContext returns the container security context of the restricted Pod Security Standard
+// with a read-only root filesystem.
+func DefaultSecurityContext() *corev1.SecurityContext {
+	return &corev1.SecurityContext{
+		AllowPrivilegeEscalation: ptr.To(false),
+		ReadOnlyRootFilesystem:   ptr.To(true),
+		RunAsNonRoot:             ptr.To(true),
+		Capabilities: &corev1.Capabilities{
+			Drop: []corev1.Capability{"ALL"},
+		},
+		SeccompProfile: &corev1.SeccompProfile{
+			Type: corev1.SeccompProfileTypeRuntimeDefault,
+		},
+	}
+}
+
+// TmpVolume returns the writable volume which is mounted at TmpPath.
+func TmpVolume() corev1.Volume {
+	return corev1.Volume{
+		Name: TmpVolumeName,
+		VolumeSource: corev1.VolumeSource{
+			EmptyDir: &corev1.EmptyDirVolumeSource{},
+		},
+	}
+}
+
+// TmpVolumeMount returns the mount of the writable volume at TmpPath.
+func TmpVolumeMount() corev1.VolumeMount {
+	return corev1.VolumeMount{
+		Name:      TmpVolumeName,
+		MountPath: TmpPath,
+	}
+}
@@ -1,7 +1,137 @@
 package types
 
+import (
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
 type HPAValues struct {
+	MinReplicas              *int32 `json:"minReplicas,omitempty"`
 	MaxReplicas              int32  `json:"maxReplicas,omitempty"`
 	AverageCpuUtilization    *int32 `json:"averageCpuUtilization,omitempty"`
 	AverageMemoryUtilization *int32 `json:"averageMemoryUtilization,omitempty"`
+	// Behavior configures the scaling behavior. If nil, the defaults of Kubernetes apply.
+	Behavior *autoscalingv2.HorizontalPodAutoscalerBehavior `json:"behavior,omitempty"`
+	// Metrics are additional metric targets, for example custom or external metrics. They are added to the CPU and memory targets.
+	// If they are set, the CPU and memory targets are only added if they are set explicitly.
+	Metrics []autoscalingv2.MetricSpec `json:"metrics,omitempty"`
+}
+
+// Default sets the unset replicas to the given defaults. Without additional metrics, the unset utilization targets
+// are set to 80 percent. With additional metrics, the unset utilization targets remain unset, so that the autoscaler
+// only scales on the resources whose targets are set explicitly.
+// The maximum replicas are raised to the minimum replicas if necessary.
+func (h *HPAValues) Default(minReplicas, maxReplicas int32) {
+	if h.MinReplicas == nil {
+		h.MinReplicas = ptr.To(minReplicas)
+	}
+	if h.MaxReplicas == 0 {
+		h.MaxReplicas = maxReplicas
+	}
+	if h.MaxReplicas < *h.MinReplicas {
+		h.MaxReplicas = *h.MinReplicas
+	}
+	if len(h.Metrics) > 0 {
+		return
+	}
+	if h.AverageCpuUtilization == nil {
+		h.AverageCpuUtilization = ptr.To(int32(80))
+	}
+	if h.AverageMemoryUtilization == nil {
+		h.AverageMemoryUtilization = ptr.To(int32(80))
+	}
+}
+
+// ScalesOnResources returns true if the autoscaler can change the number of replicas, i.e. if the defaulted minimum
+// and maximum replicas differ, and if it scales on the utilization of resource requests.
+func (h *HPAValues) ScalesOnResources() bool {
+	if ptr.Deref(h.MinReplicas, h.MaxReplicas) == h.MaxReplicas {
+		return false
+	}
+	if h.AverageCpuUtilization != nil || h.AverageMemoryUtilization != nil {
+		return true
+	}
+	for _, m := range h.Metrics {
+		if m.Type == autoscalingv2.ResourceMetricSourceType || m.Type == autoscalingv2.ContainerResourceMetricSourceType {
+			return true
+		}
+	}
+	return false
+}
+
+// Spec returns the spec of a horizontal pod autoscaler which scales the deployment with the given name.
+func (h *HPAValues) Spec(deploymentName string) autoscalingv2.HorizontalPodAutoscalerSpec {
+	metrics := []autoscalingv2.MetricSpec{}
+	if h.AverageCpuUtilization != nil {
+		metrics = append(metrics, resourceMetric(corev1.ResourceCPU, h.AverageCpuUtilization))
+	}
+	if h.AverageMemoryUtilization != nil {
+		metrics = append(metrics, resourceMetric(corev1.ResourceMemory, h.AverageMemoryUtilization))
+	}
+
+	return autoscalingv2.HorizontalPodAutoscalerSpec{
+		ScaleTargetRef: autoscalingv2.CrossVersionObjectReference{
+			APIVersion: "apps/v1",
+			Kind:       "Deployment",
+			Name:       deploymentName,
+		},
+		MinReplicas: h.MinReplicas,
+		MaxReplicas: h.MaxReplicas,
+		Metrics:     append(metrics, h.Metrics...),
+		Behavior:    h.Behavior,
+	}
+}
+
+// resourceMetric returns a metric with a target of the average utilization of a resource.
+func resourceMetric(name corev1.ResourceName, averageUtilization *int32) autoscalingv2.MetricSpec {
+	return autoscalingv2.MetricSpec{
+		Type: autoscalingv2.ResourceMetricSourceType,
+		Resource: &autoscalingv2.ResourceMetricSource{
+			Name: name,
+			Target: autoscalingv2.MetricTarget{
+				Type:               autoscalingv2.UtilizationMetricType,
+				AverageUtilization: averageUtilization,
+			},
+		},
+	}
+}
+
+// SchedulingValues configure the scheduling of the pods of a component.
+type SchedulingValues struct {
+	NodeSelector      map[string]string   `json:"nodeSelector,omitempty"`
+	Tolerations       []corev1.Toleration `json:"tolerations,omitempty"`
+	Affinity          *corev1.Affinity    `json:"affinity,omitempty"`
+	PriorityClassName string              `json:"priorityClassName,omitempty"`
+	// TopologySpreadConstraints replace the default constraints of the component.
+	// Their label selector is set to the topology labels of the component.
+	TopologySpreadConstraints []corev1.TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
+}
+
+// ApplyTo sets the scheduling fields of the pod spec of a component with the given topology labels.
+// Nothing is changed if the values are nil.
+func (s *SchedulingValues) ApplyTo(spec *corev1.PodSpec, topologyLabels map[string]string) {
+	if s == nil {
+		return
+	}
+	spec.NodeSelector = s.NodeSelector
+	spec.Tolerations = s.Tolerations
+	spec.Affinity = s.Affinity
+	spec.PriorityClassName = s.PriorityClassName
+	if len(s.TopologySpreadConstraints) > 0 {
+		spec.TopologySpreadConstraints = make([]corev1.TopologySpreadConstraint, 0, len(s.TopologySpreadConstraints))
+		for _, c := range s.TopologySpreadConstraints {
+			c.LabelSelector = &metav1.LabelSelector{MatchLabels: topologyLabels}
+			spec.TopologySpreadConstraints = append(spec.TopologySpreadConstraints, c)
+		}
+	}
+}
+
+// VerticalScalingValues configure the vertical pod autoscaler of a component.
+type VerticalScalingValues struct {
+	// UpdateMode is the update mode of the vertical pod autoscaler. With "Off", it only computes recommendations.
+	UpdateMode string              `json:"updateMode,omitempty"`
+	MinAllowed corev1.ResourceList `json:"minAllowed,omitempty"`
+	MaxAllowed corev1.ResourceList `json:"maxAllowed,omitempty"`
 }
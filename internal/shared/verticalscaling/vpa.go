@@ -0,0 +1,190 @@
+package verticalscaling
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/openmcp-project/controller-utils/pkg/clusters"
+	"github.com/openmcp-project/controller-utils/pkg/resources"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	api "github.com/openmcp-project/service-provider-landscaper/api/v1alpha2"
+	"github.com/openmcp-project/service-provider-landscaper/internal/shared/identity"
+	"github.com/openmcp-project/service-provider-landscaper/internal/shared/types"
+)
+
+// GroupVersionKind is the kind of the vertical pod autoscalers. They are handled as unstructured objects,
+// because their custom resource definition only exists on workload clusters with a vertical pod autoscaler.
+var GroupVersionKind = schema.GroupVersionKind{Group: "autoscaling.k8s.io", Version: "v1", Kind: "VerticalPodAutoscaler"}
+
+// VPA is a helper to manage the vertical pod autoscalers of the components of an instance on the workload cluster.
+// The vertical pod autoscaler of a component has the name of its deployment.
+type VPA struct {
+	WorkloadCluster          *clusters.Cluster
+	WorkloadClusterNamespace string
+}
+
+// Apply creates or updates the vertical pod autoscaler of the component, or deletes it if the values are nil.
+func (v *VPA) Apply(ctx context.Context, c *identity.Component, values *types.VerticalScalingValues) error {
+	if values == nil {
+		return v.Delete(ctx, c)
+	}
+	return resources.CreateOrUpdateResource(ctx, v.WorkloadCluster.Client(), v.mutator(c, values))
+}
+
+// ForAutoscaler returns the values of the vertical pod autoscaler of a component with the given, defaulted horizontal
+// pod autoscaler. If the horizontal pod autoscaler can change the number of replicas based on the utilization of the
+// resource requests, the update mode is Off, so that the vertical pod autoscaler does not change these requests,
+// and only computes recommendations.
+func ForAutoscaler(values *types.VerticalScalingValues, hpa *types.HPAValues) *types.VerticalScalingValues {
+	if values == nil || !hpa.ScalesOnResources() {
+		return values
+	}
+	result := *values
+	result.UpdateMode = string(api.VerticalScalingUpdateModeOff)
+	return &result
+}
+
+// Delete deletes the vertical pod autoscaler of the component.
+// It succeeds if the vertical pod autoscaler is not installed on the workload cluster.
+func (v *VPA) Delete(ctx context.Context, c *identity.Component) error {
+	if err := resources.DeleteResource(ctx, v.WorkloadCluster.Client(), v.mutator(c, nil)); err != nil && !meta.IsNoMatchError(err) {
+		return err
+	}
+	return nil
+}
+
+// Recommendations returns the recommended resource requests of the containers of all components,
+// read from the status of their vertical pod autoscalers. Components without recommendation are omitted.
+func (v *VPA) Recommendations(ctx context.Context) ([]api.ResourceRecommendation, error) {
+	list := &unstructured.UnstructuredList{}
+	list.SetGroupVersionKind(GroupVersionKind.GroupVersion().WithKind(GroupVersionKind.Kind + "List"))
+	if err := v.WorkloadCluster.Client().List(ctx, list, client.InNamespace(v.WorkloadClusterNamespace), client.MatchingLabels(identity.ManagedByLabels())); err != nil {
+		return nil, fmt.Errorf("failed to list vertical pod autoscalers: %w", err)
+	}
+
+	recommendations := []api.ResourceRecommendation{}
+	for _, item := range list.Items {
+		s := &vpaStatus{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(item.UnstructuredContent(), s); err != nil {
+			return nil, fmt.Errorf("failed to read status of vertical pod autoscaler %s: %w", item.GetName(), err)
+		}
+		if s.Status.Recommendation == nil {
+			continue
+		}
+		for _, r := range s.Status.Recommendation.ContainerRecommendations {
+			recommendations = append(recommendations, api.ResourceRecommendation{
+				Component:  item.GetName(),
+				Container:  r.ContainerName,
+				Target:     r.Target,
+				LowerBound: r.LowerBound,
+				UpperBound: r.UpperBound,
+			})
+		}
+	}
+	sort.Slice(recommendations, func(i, j int) bool {
+		if recommendations[i].Component != recommendations[j].Component {
+			return recommendations[i].Component < recommendations[j].Component
+		}
+		return recommendations[i].Container < recommendations[j].Container
+	})
+	return recommendations, nil
+}
+
+func (v *VPA) mutator(c *identity.Component, values *types.VerticalScalingValues) *vpaMutator {
+	return &vpaMutator{component: c, namespace: v.WorkloadClusterNamespace, values: values, metadata: resources.NewMetadataMutator()}
+}
+
+// vpaSpec and vpaStatus contain the fields of a vertical pod autoscaler which are set resp. read.
+type vpaSpec struct {
+	TargetRef      autoscalingv1.CrossVersionObjectReference `json:"targetRef"`
+	UpdatePolicy   vpaUpdatePolicy                           `json:"updatePolicy"`
+	ResourcePolicy vpaResourcePolicy                         `json:"resourcePolicy"`
+}
+
+type vpaUpdatePolicy struct {
+	UpdateMode string `json:"updateMode,omitempty"`
+}
+
+type vpaResourcePolicy struct {
+	ContainerPolicies []vpaContainerPolicy `json:"containerPolicies"`
+}
+
+type vpaContainerPolicy struct {
+	ContainerName       string                `json:"containerName"`
+	ControlledResources []corev1.ResourceName `json:"controlledResources,omitempty"`
+	MinAllowed          corev1.ResourceList   `json:"minAllowed,omitempty"`
+	MaxAllowed          corev1.ResourceList   `json:"maxAllowed,omitempty"`
+}
+
+type vpaStatus struct {
+	Status struct {
+		Recommendation *struct {
+			ContainerRecommendations []struct {
+				ContainerName string              `json:"containerName"`
+				Target        corev1.ResourceList `json:"target,omitempty"`
+				LowerBound    corev1.ResourceList `json:"lowerBound,omitempty"`
+				UpperBound    corev1.ResourceList `json:"upperBound,omitempty"`
+			} `json:"containerRecommendations,omitempty"`
+		} `json:"recommendation,omitempty"`
+	} `json:"status"`
+}
+
+type vpaMutator struct {
+	component *identity.Component
+	namespace string
+	values    *types.VerticalScalingValues
+	metadata  resources.MetadataMutator
+}
+
+var _ resources.Mutator[*unstructured.Unstructured] = &vpaMutator{}
+
+func (m *vpaMutator) String() string {
+	return fmt.Sprintf("vpa %s/%s", m.namespace, m.component.NamespacedDefaultResourceName())
+}
+
+func (m *vpaMutator) Empty() *unstructured.Unstructured {
+	r := &unstructured.Unstructured{}
+	r.SetGroupVersionKind(GroupVersionKind)
+	r.SetName(m.component.NamespacedDefaultResourceName())
+	r.SetNamespace(m.namespace)
+	return r
+}
+
+func (m *vpaMutator) MetadataMutator() resources.MetadataMutator {
+	return m.metadata
+}
+
+func (m *vpaMutator) Mutate(r *unstructured.Unstructured) error {
+	r.SetLabels(m.component.Labels())
+	spec, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&vpaSpec{
+		TargetRef: autoscalingv1.CrossVersionObjectReference{
+			APIVersion: "apps/v1",
+			Kind:       "Deployment",
+			Name:       m.component.NamespacedDefaultResourceName(),
+		},
+		UpdatePolicy: vpaUpdatePolicy{UpdateMode: m.values.UpdateMode},
+		ResourcePolicy: vpaResourcePolicy{
+			ContainerPolicies: []vpaContainerPolicy{
+				{
+					ContainerName:       "*",
+					ControlledResources: []corev1.ResourceName{corev1.ResourceCPU, corev1.ResourceMemory},
+					MinAllowed:          m.values.MinAllowed,
+					MaxAllowed:          m.values.MaxAllowed,
+				},
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to convert spec of %s: %w", m.String(), err)
+	}
+	r.Object["spec"] = spec
+	return nil
+}